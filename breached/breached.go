@@ -0,0 +1,120 @@
+// Package breached tells whether a password is known from data breaches. It
+// works offline against a local corpus of SHA-1 hashes, such as the Pwned
+// Passwords downloads, so passwords never leave the service.
+package breached
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+//go:embed common.txt
+var common []byte
+
+// List is a sorted set of the first 8 bytes of SHA-1 hashes of breached
+// passwords, held in memory: 8 bytes per password, so ten million passwords
+// take 80 MB. The whole Pwned Passwords corpus would take gigabytes, so load
+// a subset of it, such as its most common passwords. With a prefix of 8
+// bytes the chance that a password matches by accident stays below one in a
+// trillion for lists of that size.
+type List struct {
+	prefixes []uint64
+}
+
+var (
+	commonOnce sync.Once
+	commonList *List
+)
+
+// Common returns the built-in list of the most common passwords. It is
+// parsed once and shared, as lists are never changed.
+func Common() *List {
+	commonOnce.Do(func() {
+		list, err := Parse(bytes.NewReader(common))
+		if err != nil {
+			panic(err)
+		}
+		commonList = list
+	})
+	return commonList
+}
+
+// Parse reads a corpus of one hex encoded SHA-1 hash per line. A ":count"
+// suffix, as in the Pwned Passwords downloads, is ignored, as are empty
+// lines and lines starting with "#". The lines need not be sorted.
+func Parse(r io.Reader) (*List, error) {
+	var prefixes []uint64
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		hash, _, _ := bytes.Cut(line, []byte(":"))
+		var sum [sha1.Size]byte
+		err := hex.ErrLength
+		if len(hash) == hex.EncodedLen(sha1.Size) {
+			_, err = hex.Decode(sum[:], hash)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("breached: line %d is not a SHA-1 hash", n)
+		}
+		prefixes = append(prefixes, binary.BigEndian.Uint64(sum[:]))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return newList(prefixes), nil
+}
+
+// LoadFile parses the corpus in the file at path.
+func LoadFile(path string) (*List, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Merge returns a list of the passwords of all lists.
+func Merge(lists ...*List) *List {
+	var prefixes []uint64
+	for _, list := range lists {
+		prefixes = append(prefixes, list.prefixes...)
+	}
+	return newList(prefixes)
+}
+
+func newList(prefixes []uint64) *List {
+	sort.Slice(prefixes, func(i, j int) bool { return prefixes[i] < prefixes[j] })
+	unique := prefixes[:0]
+	for i, p := range prefixes {
+		if i == 0 || p != prefixes[i-1] {
+			unique = append(unique, p)
+		}
+	}
+	return &List{prefixes: unique}
+}
+
+// Len returns the number of passwords in the list.
+func (l *List) Len() int {
+	return len(l.prefixes)
+}
+
+// Contains reports whether password is in the list.
+func (l *List) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	prefix := binary.BigEndian.Uint64(sum[:])
+	i := sort.Search(len(l.prefixes), func(i int) bool { return l.prefixes[i] >= prefix })
+	return i < len(l.prefixes) && l.prefixes[i] == prefix
+}
@@ -0,0 +1,65 @@
+package breached
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Common(t *testing.T) {
+	list := Common()
+	assert.True(t, list.Contains("Password1!"))
+	assert.True(t, list.Contains("P@ssw0rd"))
+	assert.False(t, list.Contains("password1!"))
+	assert.False(t, list.Contains("kebun-Sawit-77"))
+	assert.Same(t, list, Common())
+}
+
+func Test_Parse(t *testing.T) {
+	corpus := `# Pwned Passwords format
+7C4A8D09CA3762AF61E59520943DC26494F8941B:37359195
+
+5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8:10434004
+7C4A8D09CA3762AF61E59520943DC26494F8941B
+`
+	list, err := Parse(strings.NewReader(corpus))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, list.Len())
+	assert.True(t, list.Contains("123456"))
+	assert.True(t, list.Contains("password"))
+	assert.False(t, list.Contains("Password1!"))
+
+	merged := Merge(list, Common())
+	assert.True(t, merged.Contains("password"))
+	assert.True(t, merged.Contains("Password1!"))
+
+	_, err = Parse(strings.NewReader("7C4A8D09CA37\n"))
+	assert.EqualError(t, err, "breached: line 1 is not a SHA-1 hash")
+	_, err = Parse(strings.NewReader("password\n"))
+	assert.Error(t, err)
+}
+
+// Benchmark_Parse loads a corpus of ten million hashes, the size the doc of
+// List is written for.
+func Benchmark_Parse(b *testing.B) {
+	var corpus strings.Builder
+	for i := 0; i < 10_000_000; i++ {
+		sum := sha1.Sum([]byte(strconv.Itoa(i)))
+		corpus.WriteString(hex.EncodeToString(sum[:]))
+		corpus.WriteString(":1\n")
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list, err := Parse(strings.NewReader(corpus.String()))
+		if err != nil {
+			b.Fatal(err)
+		}
+		if !list.Contains("9999999") {
+			b.Fatal("missing password")
+		}
+	}
+}
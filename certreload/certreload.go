@@ -0,0 +1,108 @@
+// Package certreload serves a TLS certificate from disk and reloads it when
+// its files change, so that a renewed certificate is picked up without
+// restarting the service.
+package certreload
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader holds the certificate of a pair of PEM files. It is safe for
+// concurrent use.
+type Reloader struct {
+	certFile string
+	keyFile  string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	// modTime is the latest modification time of the files when they were
+	// loaded.
+	modTime time.Time
+}
+
+// New loads the certificate and key in certFile and keyFile.
+func New(certFile, keyFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the files again. The previous certificate is kept when they
+// cannot be loaded, such as when only one of them was replaced yet.
+func (r *Reloader) Reload() error {
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("certreload: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.modTime = modTime
+	return nil
+}
+
+// GetCertificate returns the current certificate. It is meant for
+// tls.Config.GetCertificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// Watch checks the files every interval until ctx is done, and reloads them
+// when they changed. A failed reload is passed to onError and tried again
+// at the next check.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.reloadIfChanged(); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func (r *Reloader) reloadIfChanged() error {
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+	r.mu.RLock()
+	changed := !modTime.Equal(r.modTime)
+	r.mu.RUnlock()
+	if !changed {
+		return nil
+	}
+	return r.Reload()
+}
+
+func (r *Reloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("certreload: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
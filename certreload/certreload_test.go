@@ -0,0 +1,118 @@
+package certreload
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeCert writes a self-signed certificate for commonName and its key,
+// dated at modTime.
+func writeCert(t *testing.T, certFile, keyFile, commonName string, modTime time.Time) {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() err = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &private.PublicKey, private)
+	if err != nil {
+		t.Fatalf("CreateCertificate() err = %v", err)
+	}
+	key, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() err = %v", err)
+	}
+	files := map[string][]byte{
+		certFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyFile:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(name, data, 0600); err != nil {
+			t.Fatalf("WriteFile() err = %v", err)
+		}
+		if err := os.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatalf("Chtimes() err = %v", err)
+		}
+	}
+}
+
+func commonName(t *testing.T, r *Reloader) string {
+	cert, err := r.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate() err = %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("ParseCertificate() err = %v", err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func Test_Reloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	start := time.Now().Add(-time.Hour)
+	writeCert(t, certFile, keyFile, "first", start)
+
+	r, err := New(certFile, keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "first", commonName(t, r))
+
+	// Unchanged files are not loaded again.
+	assert.NoError(t, r.reloadIfChanged())
+	assert.Equal(t, "first", commonName(t, r))
+
+	// A key that does not match the certificate yet keeps the previous
+	// certificate, and is loaded once the certificate follows.
+	writeCert(t, filepath.Join(dir, "next.crt"), keyFile, "second", start.Add(time.Minute))
+	assert.Error(t, r.reloadIfChanged())
+	assert.Equal(t, "first", commonName(t, r))
+
+	if err := os.Rename(filepath.Join(dir, "next.crt"), certFile); err != nil {
+		t.Fatalf("Rename() err = %v", err)
+	}
+	assert.NoError(t, r.reloadIfChanged())
+	assert.Equal(t, "second", commonName(t, r))
+}
+
+func Test_Reloader_Watch(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	writeCert(t, certFile, keyFile, "first", time.Now().Add(-time.Hour))
+	r, err := New(certFile, keyFile)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Watch(ctx, time.Millisecond, func(err error) { t.Errorf("Watch() err = %v", err) })
+		close(done)
+	}()
+
+	writeCert(t, certFile, keyFile, "renewed", time.Now())
+	assert.Eventually(t, func() bool { return commonName(t, r) == "renewed" }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+}
+
+func Test_New(t *testing.T) {
+	dir := t.TempDir()
+	_, err := New(filepath.Join(dir, "missing.crt"), filepath.Join(dir, "missing.key"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,233 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// refreshLeeway is how long before its expiry an access token is refreshed,
+// so that it does not expire on the way to the server.
+const refreshLeeway = 30 * time.Second
+
+// TokenSource hands out the access token attached to the requests of a
+// client.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Refresher is a TokenSource that can replace an access token the server
+// rejected.
+type Refresher interface {
+	TokenSource
+	// Refresh replaces rejected with a new access token. It does nothing
+	// when rejected was already replaced by a concurrent request.
+	Refresh(ctx context.Context, rejected string) error
+}
+
+// StaticToken is a TokenSource of an access token that is never refreshed.
+type StaticToken string
+
+// Token implements TokenSource.
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Tokens are the credentials of a signed in user.
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	// Expiry is when AccessToken expires. When zero, the access token is
+	// only refreshed once the server rejects it.
+	Expiry time.Time
+}
+
+// TokensFromResponse returns the tokens answered by a login or a refresh
+// received at now.
+func TokensFromResponse(resp TokenResponse, now time.Time) Tokens {
+	return Tokens{
+		AccessToken:  resp.Token,
+		RefreshToken: resp.RefreshToken,
+		Expiry:       now.Add(time.Duration(resp.ExpiresIn) * time.Second),
+	}
+}
+
+// RefreshingTokenSource is a Refresher that exchanges the refresh token of
+// a user at /v2/token/refresh shortly before the access token expires, or
+// after the server rejected it. It is safe for concurrent use; refreshes
+// are serialized because the server revokes every token of a user whose
+// refresh token is used twice.
+type RefreshingTokenSource struct {
+	client ClientInterface
+
+	// OnRefresh, if set, is called with the tokens of each refresh. Refresh
+	// tokens are single use, so callers that keep them must store the new
+	// ones.
+	OnRefresh func(Tokens)
+
+	mu     sync.Mutex
+	tokens Tokens
+	now    func() time.Time
+}
+
+// NewRefreshingTokenSource returns a RefreshingTokenSource of tokens that
+// refreshes them through client. client must not authenticate its requests
+// with the returned source itself.
+func NewRefreshingTokenSource(client ClientInterface, tokens Tokens) *RefreshingTokenSource {
+	return &RefreshingTokenSource{
+		client: client,
+		tokens: tokens,
+		now:    time.Now,
+	}
+}
+
+// Tokens returns the current tokens.
+func (s *RefreshingTokenSource) Tokens() Tokens {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens
+}
+
+// Token implements TokenSource.
+func (s *RefreshingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.tokens.Expiry.IsZero() && !s.now().Add(refreshLeeway).Before(s.tokens.Expiry) {
+		if err := s.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.tokens.AccessToken, nil
+}
+
+// Refresh implements Refresher.
+func (s *RefreshingTokenSource) Refresh(ctx context.Context, rejected string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens.AccessToken != rejected {
+		return nil
+	}
+	return s.refresh(ctx)
+}
+
+// refresh exchanges the refresh token. s.mu must be held.
+func (s *RefreshingTokenSource) refresh(ctx context.Context) error {
+	if s.tokens.RefreshToken == "" {
+		return errors.New("client: access token expired and there is no refresh token")
+	}
+	httpResp, err := s.client.PostV2TokenRefresh(ctx, PostV2TokenRefreshJSONRequestBody{RefreshToken: s.tokens.RefreshToken})
+	if err != nil {
+		return fmt.Errorf("client: refresh token: %w", err)
+	}
+	resp, err := ParsePostV2TokenRefreshResponse(httpResp)
+	if err != nil {
+		return fmt.Errorf("client: refresh token: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("client: refresh token: %w", NewProblemError(resp.HTTPResponse, resp.Body))
+	}
+
+	s.tokens = TokensFromResponse(*resp.JSON200, s.now())
+	if s.OnRefresh != nil {
+		s.OnRefresh(s.tokens)
+	}
+	return nil
+}
+
+// WithBearerToken authenticates every request with the access token of
+// source, unless the request already carries an Authorization header. When
+// source is a Refresher, a request answered with 401 Unauthorized is sent
+// once more with a refreshed token. It wraps the HTTP client set by the
+// options before it, so WithHTTPClient must come first.
+func WithBearerToken(source TokenSource) ClientOption {
+	return func(c *Client) error {
+		c.Client = &bearerDoer{next: doer(c.Client), source: source}
+		return nil
+	}
+}
+
+type bearerDoer struct {
+	next   HttpRequestDoer
+	source TokenSource
+}
+
+func (d *bearerDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		return d.next.Do(req)
+	}
+
+	token, err := d.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.next.Do(withBearer(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	refresher, ok := d.source.(Refresher)
+	if !ok || !replayable(req) {
+		return resp, nil
+	}
+
+	if err := refresher.Refresh(req.Context(), token); err != nil {
+		// The 401 says more about the failure than the refresh does.
+		return resp, nil
+	}
+	if token, err = refresher.Token(req.Context()); err != nil {
+		return resp, nil
+	}
+	retry, err := rewind(withBearer(req, token))
+	if err != nil {
+		return resp, nil
+	}
+	drain(resp)
+	return d.next.Do(retry)
+}
+
+// withBearer returns a copy of req authenticated with token. Requests must
+// not be modified by a HttpRequestDoer.
+func withBearer(req *http.Request, token string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+// replayable reports whether req can be sent again.
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind returns a copy of req with a fresh copy of its body, to send it
+// again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+// drain closes a response that is discarded for another attempt, reading
+// it first so its connection can be reused.
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+}
+
+// doer returns the HTTP client set by earlier options, or the one NewClient
+// defaults to.
+func doer(d HttpRequestDoer) HttpRequestDoer {
+	if d == nil {
+		return &http.Client{}
+	}
+	return d
+}
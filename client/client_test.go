@@ -0,0 +1,339 @@
+package client
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/handler"
+	"github.com/SawitProRecruitment/UserService/keys"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// testService runs handler.Server behind an httptest server, wired like
+// cmd/main.go. wrap, if set, sits in front of it.
+type testService struct {
+	repo   *repository.MockRepositoryInterface
+	keySet *keys.KeySet
+	server *httptest.Server
+}
+
+func newTestService(t *testing.T, wrap func(http.Handler) http.Handler) *testService {
+	t.Helper()
+	key, err := keys.Generate("test", keys.AlgorithmES256)
+	if err != nil {
+		t.Fatalf("keys.Generate() err = %v", err)
+	}
+	keySet, err := keys.NewKeySet([]*keys.Key{key}, "")
+	if err != nil {
+		t.Fatalf("keys.NewKeySet() err = %v", err)
+	}
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() err = %v", err)
+	}
+
+	repo := repository.NewMockRepositoryInterface(gomock.NewController(t))
+	s := handler.NewServer(handler.NewServerOptions{
+		Repository:  repo,
+		Revocations: repository.NewMemoryRevocationStore(),
+		RateLimiter: repository.NewMemoryRateLimitStore(),
+		Keys:        keySet,
+	})
+	e := echo.New()
+	e.HTTPErrorHandler = s.HTTPErrorHandler
+	e.Use(handler.RequestIDMiddleware())
+	generated.RegisterHandlers(e.Group("", s.RateLimitMiddleware(), s.AuthMiddleware(swagger), s.ValidationMiddleware(swagger, handler.ValidationOptions{Responses: true})), s)
+
+	var h http.Handler = e
+	if wrap != nil {
+		h = wrap(h)
+	}
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	return &testService{repo: repo, keySet: keySet, server: server}
+}
+
+// accessToken signs an access token of user 1 that expires after ttl.
+func (ts *testService) accessToken(t *testing.T, ttl time.Duration) string {
+	t.Helper()
+	claims := &handler.Claims{}
+	claims.Subject = "1"
+	claims.Id = strconv.FormatInt(time.Now().UnixNano(), 10)
+	claims.IssuedAt = time.Now().Unix()
+	claims.ExpiresAt = time.Now().Add(ttl).Unix()
+	token, err := ts.keySet.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() err = %v", err)
+	}
+	return token
+}
+
+// expectRefresh expects the refresh token of user 1 to be exchanged.
+func (ts *testService) expectRefresh() {
+	ts.repo.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(repository.RefreshTokenOutput{ID: 7, UserID: 1, FamilyID: "family", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	ts.repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1}, nil)
+	ts.repo.EXPECT().RotateRefreshToken(gomock.Any(), 7, gomock.Any()).Return(true, nil)
+}
+
+// expectProfile expects the profile of user 1 to be read.
+func (ts *testService) expectProfile() {
+	ts.repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1, Name: "aaa", PhoneNumber: "+62888732928"}, nil)
+}
+
+func Test_WithBearerToken(t *testing.T) {
+	ts := newTestService(t, nil)
+	ts.expectProfile()
+
+	c, err := NewClientWithResponses(ts.server.URL, WithBearerToken(StaticToken(ts.accessToken(t, time.Minute))))
+	if err != nil {
+		t.Fatalf("NewClientWithResponses() err = %v", err)
+	}
+	resp, err := c.GetV2MeWithResponse(context.Background())
+	if err != nil {
+		t.Fatalf("GetV2MeWithResponse() err = %v", err)
+	}
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, &Profile{Name: "aaa", PhoneNumber: "+62888732928"}, resp.JSON200)
+
+	// A token set on the request itself wins.
+	resp, err = c.GetV2MeWithResponse(context.Background(), func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer invalid")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("GetV2MeWithResponse() err = %v", err)
+	}
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	problem := NewProblemError(resp.HTTPResponse, resp.Body)
+	assert.Equal(t, "token_invalid", problem.Problem.Code)
+	assert.EqualError(t, problem, "401 token_invalid: Token is not valid")
+}
+
+func Test_RefreshingTokenSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		tokens      func(ts *testService) Tokens
+		mockFunc    func(ts *testService)
+		wantCode    int
+		wantRefresh bool
+	}{
+		{
+			name: "valid access token",
+			tokens: func(ts *testService) Tokens {
+				return Tokens{AccessToken: ts.accessToken(t, time.Minute), RefreshToken: "refresh", Expiry: time.Now().Add(time.Minute)}
+			},
+			mockFunc: func(ts *testService) {
+				ts.expectProfile()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "access token about to expire",
+			tokens: func(ts *testService) Tokens {
+				return Tokens{AccessToken: ts.accessToken(t, time.Minute), RefreshToken: "refresh", Expiry: time.Now().Add(refreshLeeway / 2)}
+			},
+			mockFunc: func(ts *testService) {
+				ts.expectRefresh()
+				ts.expectProfile()
+			},
+			wantCode:    http.StatusOK,
+			wantRefresh: true,
+		},
+		{
+			name: "access token rejected",
+			tokens: func(ts *testService) Tokens {
+				return Tokens{AccessToken: "rejected", RefreshToken: "refresh"}
+			},
+			mockFunc: func(ts *testService) {
+				ts.expectRefresh()
+				ts.expectProfile()
+			},
+			wantCode:    http.StatusOK,
+			wantRefresh: true,
+		},
+		{
+			name: "refresh token rejected",
+			tokens: func(ts *testService) Tokens {
+				return Tokens{AccessToken: "rejected", RefreshToken: "refresh"}
+			},
+			mockFunc: func(ts *testService) {
+				ts.repo.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(repository.RefreshTokenOutput{}, sql.ErrNoRows)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := newTestService(t, nil)
+			test.mockFunc(ts)
+
+			tokens := test.tokens(ts)
+			refreshClient, err := NewClient(ts.server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() err = %v", err)
+			}
+			source := NewRefreshingTokenSource(refreshClient, tokens)
+			var refreshed []Tokens
+			source.OnRefresh = func(tokens Tokens) {
+				refreshed = append(refreshed, tokens)
+			}
+			c, err := NewClientWithResponses(ts.server.URL, WithBearerToken(source))
+			if err != nil {
+				t.Fatalf("NewClientWithResponses() err = %v", err)
+			}
+
+			resp, err := c.GetV2MeWithResponse(context.Background())
+			if err != nil {
+				t.Fatalf("GetV2MeWithResponse() err = %v", err)
+			}
+			assert.Equal(t, test.wantCode, resp.StatusCode())
+			if !test.wantRefresh {
+				assert.Empty(t, refreshed)
+				assert.Equal(t, tokens, source.Tokens())
+				return
+			}
+			if assert.Len(t, refreshed, 1) {
+				assert.Equal(t, refreshed[0], source.Tokens())
+				assert.NotEqual(t, tokens.RefreshToken, refreshed[0].RefreshToken)
+				assert.NotEqual(t, tokens.AccessToken, refreshed[0].AccessToken)
+				assert.True(t, refreshed[0].Expiry.After(time.Now()))
+			}
+		})
+	}
+}
+
+func Test_WithRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	tests := []struct {
+		name         string
+		failures     int32
+		retryAfter   string
+		mockFunc     func(ts *testService)
+		call         func(c *ClientWithResponses) (int, error)
+		wantCode     int
+		wantAttempts int32
+	}{
+		{
+			name:     "idempotent request recovers",
+			failures: 2,
+			mockFunc: func(ts *testService) {
+				ts.expectProfile()
+			},
+			call: func(c *ClientWithResponses) (int, error) {
+				resp, err := c.GetV2MeWithResponse(context.Background())
+				if err != nil {
+					return 0, err
+				}
+				return resp.StatusCode(), nil
+			},
+			wantCode:     http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:     "idempotent request gives up",
+			failures: 3,
+			mockFunc: func(ts *testService) {},
+			call: func(c *ClientWithResponses) (int, error) {
+				resp, err := c.GetV2MeWithResponse(context.Background())
+				if err != nil {
+					return 0, err
+				}
+				return resp.StatusCode(), nil
+			},
+			wantCode:     http.StatusServiceUnavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:       "retry after beyond the backoff",
+			failures:   1,
+			retryAfter: "60",
+			mockFunc:   func(ts *testService) {},
+			call: func(c *ClientWithResponses) (int, error) {
+				resp, err := c.GetV2MeWithResponse(context.Background())
+				if err != nil {
+					return 0, err
+				}
+				return resp.StatusCode(), nil
+			},
+			wantCode:     http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:     "request that is not idempotent",
+			failures: 1,
+			mockFunc: func(ts *testService) {},
+			call: func(c *ClientWithResponses) (int, error) {
+				resp, err := c.PostLogoutWithResponse(context.Background())
+				if err != nil {
+					return 0, err
+				}
+				return resp.StatusCode(), nil
+			},
+			wantCode:     http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int32
+			ts := newTestService(t, func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if atomic.AddInt32(&attempts, 1) <= test.failures {
+						if test.retryAfter != "" {
+							w.Header().Set("Retry-After", test.retryAfter)
+						}
+						w.WriteHeader(http.StatusServiceUnavailable)
+						return
+					}
+					next.ServeHTTP(w, r)
+				})
+			})
+			test.mockFunc(ts)
+
+			c, err := NewClientWithResponses(ts.server.URL, WithRetry(policy), WithBearerToken(StaticToken(ts.accessToken(t, time.Minute))))
+			if err != nil {
+				t.Fatalf("NewClientWithResponses() err = %v", err)
+			}
+			code, err := test.call(c)
+			if err != nil {
+				t.Fatalf("call err = %v", err)
+			}
+			assert.Equal(t, test.wantCode, code)
+			assert.Equal(t, test.wantAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func Test_RefreshingTokenSource_RequestBody(t *testing.T) {
+	ts := newTestService(t, nil)
+	ts.expectRefresh()
+	ts.repo.EXPECT().UpdateUserByID(gomock.Any(), 1, repository.UserInput{FullName: "bbbb"}).Return(nil)
+
+	refreshClient, err := NewClient(ts.server.URL)
+	if err != nil {
+		t.Fatalf("NewClient() err = %v", err)
+	}
+	source := NewRefreshingTokenSource(refreshClient, Tokens{AccessToken: "rejected", RefreshToken: "refresh"})
+	c, err := NewClientWithResponses(ts.server.URL, WithBearerToken(source))
+	if err != nil {
+		t.Fatalf("NewClientWithResponses() err = %v", err)
+	}
+
+	fullName := "bbbb"
+	resp, err := c.PatchV2MeWithResponse(context.Background(), PatchV2MeJSONRequestBody{FullName: &fullName})
+	if err != nil {
+		t.Fatalf("PatchV2MeWithResponse() err = %v", err)
+	}
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, &Response{Message: "Successfully updated user data"}, resp.JSON200)
+}
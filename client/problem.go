@@ -0,0 +1,37 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ProblemError is a failed response of the service. Problem holds its RFC
+// 7807 body; clients branch on Problem.Code.
+type ProblemError struct {
+	StatusCode int
+	Problem    Problem
+}
+
+// NewProblemError returns the error of a response that was not successful.
+// Bodies that are not problems, such as those of a proxy in front of the
+// service, get a problem made up from the status.
+func NewProblemError(resp *http.Response, body []byte) *ProblemError {
+	e := &ProblemError{StatusCode: resp.StatusCode}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/problem+json") && json.Unmarshal(body, &e.Problem) == nil {
+		return e
+	}
+	e.Problem = Problem{
+		Status: resp.StatusCode,
+		Title:  http.StatusText(resp.StatusCode),
+	}
+	return e
+}
+
+func (e *ProblemError) Error() string {
+	if e.Problem.Code == "" {
+		return fmt.Sprintf("%d %s", e.StatusCode, e.Problem.Title)
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Problem.Code, e.Problem.Title)
+}
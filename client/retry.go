@@ -0,0 +1,118 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy says how requests that failed for a transient reason are sent
+// again. Only idempotent requests are retried, as the others may have taken
+// effect before failing.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts, including the first one.
+	MaxAttempts int
+	// Backoff is the wait before the first retry. It doubles with each
+	// retry, up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy makes up to 3 attempts over about a third of a second.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     100 * time.Millisecond,
+	MaxBackoff:  2 * time.Second,
+}
+
+// WithRetry sends idempotent requests again when they fail with a network
+// error, 429 Too Many Requests, 502 Bad Gateway, 503 Service Unavailable or
+// 504 Gateway Timeout. A Retry-After header longer than MaxBackoff stops the
+// retries. It wraps the HTTP client set by the options before it, so
+// WithHTTPClient must come first.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.Client = &retryDoer{next: doer(c.Client), policy: policy}
+		return nil
+	}
+}
+
+type retryDoer struct {
+	next   HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	if !idempotent(req.Method) || !replayable(req) {
+		return d.next.Do(req)
+	}
+
+	backoff := d.policy.Backoff
+	for attempt := 1; ; attempt++ {
+		resp, err := d.next.Do(req)
+		if attempt >= d.policy.MaxAttempts || req.Context().Err() != nil || !transient(resp, err) {
+			return resp, err
+		}
+
+		wait := backoff
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				if after > d.policy.MaxBackoff {
+					return resp, nil
+				}
+				wait = after
+			}
+		}
+		retry, rewindErr := rewind(req)
+		if rewindErr != nil {
+			return resp, err
+		}
+		if resp != nil {
+			drain(resp)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = retry
+		if backoff *= 2; backoff > d.policy.MaxBackoff {
+			backoff = d.policy.MaxBackoff
+		}
+	}
+}
+
+// idempotent reports whether sending a request of method twice has the same
+// effect as sending it once (RFC 9110, section 9.2.2).
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// transient reports whether a failed attempt may succeed when sent again.
+func transient(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait asked for by the Retry-After header of resp,
+// in seconds. HTTP dates are not used by the service and are ignored.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
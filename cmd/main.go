@@ -1,31 +1,337 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 
+	"github.com/SawitProRecruitment/UserService/breached"
+	"github.com/SawitProRecruitment/UserService/certreload"
+	"github.com/SawitProRecruitment/UserService/config"
 	"github.com/SawitProRecruitment/UserService/generated"
 	"github.com/SawitProRecruitment/UserService/handler"
+	"github.com/SawitProRecruitment/UserService/health"
+	"github.com/SawitProRecruitment/UserService/keys"
+	"github.com/SawitProRecruitment/UserService/metrics"
+	"github.com/SawitProRecruitment/UserService/migrations"
 	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/SawitProRecruitment/UserService/sms"
 
 	"github.com/labstack/echo/v4"
 )
 
 func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config:\n%v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		fmt.Print(cfg)
+		return
+	}
+
+	repo := repository.NewRepository(repository.NewRepositoryOptions{
+		Dsn:             cfg.Database.URL,
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+	})
+	// sql.Open does not connect, so the database is pinged to fail at
+	// startup, after the configured retries, rather than on the first
+	// request.
+	db := cfg.Database
+	if err := repo.WaitForDatabase(context.Background(), db.ConnectAttempts, db.ConnectBackoff, db.ConnectMaxBackoff); err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrate(repo, os.Args[2:])
+		return
+	}
+	log.Printf("starting with config:\n%s", cfg)
+	// The schema is brought up to date before serving unless auto_migrate
+	// is off, for deployments that run "migrate up" as a separate step.
+	if cfg.Database.AutoMigrate {
+		migrate(repo, []string{"up"})
+	}
+
 	e := echo.New()
+	// Rate limits are keyed by client IP, so X-Forwarded-For is only trusted
+	// when the service runs behind a proxy that sets it.
+	if cfg.Server.TrustProxy {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
 
-	var server generated.ServerInterface = newServer()
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		log.Fatalf("failed to load api spec: %v", err)
+	}
 
-	generated.RegisterHandlers(e, server)
-	e.Logger.Fatal(e.Start(":1323"))
+	server := newServer(cfg, repo)
+	e.HTTPErrorHandler = server.HTTPErrorHandler
+	if cfg.Server.Metrics {
+		// The metrics middleware comes first to count the requests that
+		// the other middleware reject.
+		e.Use(metrics.Middleware())
+		if err := metrics.RegisterDB(repo.Db, "users"); err != nil {
+			log.Fatalf("failed to register database metrics: %v", err)
+		}
+	}
+	e.Use(handler.RequestIDMiddleware(), handler.BodyLimitMiddleware(cfg.Server.MaxBodyBytes))
+
+	// Every route is registered through a group so that the rate limits,
+	// the security requirements and the schemas of api.yml are enforced
+	// before the handlers run.
+	validation := handler.ValidationOptions{Responses: cfg.Server.ValidateResponses}
+	generated.RegisterHandlers(e.Group("", server.RateLimitMiddleware(), server.AuthMiddleware(swagger), server.ValidationMiddleware(swagger, validation)), server)
+	// The probes of the orchestrator are not part of the API.
+	e.GET("/healthz", echo.WrapHandler(health.LiveHandler()))
+	e.GET("/readyz", echo.WrapHandler(newReadiness(cfg.Server, repo, server).Handler()))
+	if cfg.Server.Metrics {
+		e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+	}
+	serve(cfg.Server, e)
+	if err := repo.Db.Close(); err != nil {
+		log.Printf("failed to close database: %v", err)
+	}
 }
 
-func newServer() *handler.Server {
-	dbDsn := os.Getenv("DATABASE_URL")
-	var repo repository.RepositoryInterface = repository.NewRepository(repository.NewRepositoryOptions{
-		Dsn: dbDsn,
-	})
+// newReadiness registers what the service needs to serve: a reachable
+// database at the schema of this binary, and a key to sign tokens with.
+func newReadiness(cfg config.Server, repo *repository.Repository, server *handler.Server) *health.Registry {
+	readiness := health.NewRegistry(cfg.ReadinessTimeout)
+	readiness.Register("database", health.CheckerFunc(repo.Db.PingContext))
+	readiness.Register("migrations", migrations.NewMigrator(repo.Db))
+	readiness.Register("signing_keys", health.CheckerFunc(func(ctx context.Context) error {
+		return server.Keys.Check()
+	}))
+	return readiness
+}
+
+// serve runs the HTTP server until SIGINT or SIGTERM, then stops accepting
+// connections and waits up to the shutdown timeout for in-flight requests
+// to finish.
+func serve(cfg config.Server, e *echo.Echo) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	s := &http.Server{
+		Addr:              cfg.Addr,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+	if cfg.TLS.Enabled() {
+		s.TLSConfig = newTLSConfig(ctx, cfg.TLS)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- e.StartServer(s)
+	}()
+	select {
+	case err := <-errs:
+		log.Fatalf("failed to serve: %v", err)
+	case <-ctx.Done():
+	}
+	// A second signal stops the service without waiting.
+	stop()
+
+	log.Printf("shutting down, waiting up to %s for in-flight requests", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := s.Shutdown(shutdownCtx); err != nil {
+		log.Printf("failed to drain connections: %v", err)
+	}
+	if err := <-errs; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("failed to serve: %v", err)
+	}
+}
+
+// newTLSConfig serves the certificate in the TLS files, reloading it when
+// the files change and on SIGHUP until ctx is done.
+func newTLSConfig(ctx context.Context, cfg config.TLS) *tls.Config {
+	reloader, err := certreload.New(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		log.Fatalf("failed to load TLS certificate: %v", err)
+	}
+	logError := func(err error) {
+		log.Printf("failed to reload TLS certificate, keeping the previous one: %v", err)
+	}
+	if cfg.ReloadInterval > 0 {
+		go reloader.Watch(ctx, cfg.ReloadInterval, logError)
+	}
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hangups)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hangups:
+				if err := reloader.Reload(); err != nil {
+					logError(err)
+					continue
+				}
+				log.Println("reloaded TLS certificate")
+			}
+		}
+	}()
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+}
+
+func newServer(cfg config.Config, repo *repository.Repository) *handler.Server {
+	var revocations repository.RevocationStoreInterface = repo
+	if cfg.Tokens.RevocationStore == config.StoreMemory {
+		revocations = repository.NewMemoryRevocationStore()
+	}
+	var rateLimiter repository.RateLimitStoreInterface = repo
+	if cfg.RateLimits.Store == config.StoreMemory {
+		rateLimiter = repository.NewMemoryRateLimitStore()
+	}
+	// Load validated the rate limits already.
+	rateLimits, err := cfg.RateLimits.Rules()
+	if err != nil {
+		log.Fatal(err)
+	}
 	opts := handler.NewServerOptions{
-		Repository: repo,
+		Repository:        repo,
+		Revocations:       revocations,
+		Keys:              newKeySet(cfg.Tokens),
+		AccessTokenTTL:    cfg.Tokens.AccessTTL,
+		RefreshTokenTTL:   cfg.Tokens.RefreshTTL,
+		RateLimiter:       rateLimiter,
+		RateLimits:        rateLimits,
+		TOTPIssuer:        cfg.MFA.TOTPIssuer,
+		SMS:               newSMSSender(cfg.SMS),
+		PhoneRegion:       strings.ToUpper(cfg.Phone.DefaultRegion),
+		PasswordHistory:   &cfg.Password.History,
+		PasswordHashing:   cfg.Password.Hashing.Policy(),
+		PasswordPolicy:    cfg.Password.Policy.Policy(),
+		FullNameLength:    handler.FullNameLength{Min: cfg.Profile.FullNameMinLength, Max: cfg.Profile.FullNameMaxLength},
+		BreachedPasswords: breachedPasswords(cfg.Password.BreachedFile),
 	}
 	return handler.NewServer(opts)
 }
+
+// migrate runs the "migrate" subcommand: "up" applies every pending
+// migration, "down [N]" reverts the last N (1 by default) and "status"
+// lists them.
+func migrate(repo *repository.Repository, args []string) {
+	migrator := migrations.NewMigrator(repo.Db)
+	ctx := context.Background()
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		done, err := migrator.Up(ctx)
+		for _, m := range done {
+			log.Printf("applied migration %d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("failed to migrate: %v", err)
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				log.Fatalf("invalid number of migrations to revert %q: must be a positive number", args[1])
+			}
+			steps = n
+		}
+		done, err := migrator.Down(ctx, steps)
+		for _, m := range done {
+			log.Printf("reverted migration %d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("failed to revert migrations: %v", err)
+		}
+	case "status":
+		applied, pending, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("failed to read migrations: %v", err)
+		}
+		for _, a := range applied {
+			log.Printf("applied %d_%s at %s", a.Version, a.Name, a.AppliedAt.Format("2006-01-02 15:04:05 MST"))
+		}
+		for _, m := range pending {
+			log.Printf("pending %d_%s", m.Version, m.Name)
+		}
+	default:
+		log.Fatalf("unknown migrate command %q: use up, down [N] or status", command)
+	}
+}
+
+// breachedPasswords loads the corpus in path on top of the built-in list of
+// common passwords. "off" checks no password at all.
+func breachedPasswords(path string) *breached.List {
+	switch path {
+	case "":
+		return breached.Common()
+	case "off":
+		return &breached.List{}
+	}
+	list, err := breached.LoadFile(path)
+	if err != nil {
+		log.Fatalf("failed to load breached passwords: %v", err)
+	}
+	log.Printf("loaded %d breached passwords from %s", list.Len(), path)
+	return breached.Merge(breached.Common(), list)
+}
+
+// newKeySet loads the signing keys from the keys directory. Without it an
+// ephemeral key is generated, which is only suitable for local development
+// because tokens stop verifying after a restart.
+func newKeySet(cfg config.Tokens) *keys.KeySet {
+	if cfg.KeysDir != "" {
+		keySet, err := keys.LoadDir(cfg.KeysDir, cfg.SigningKeyID)
+		if err != nil {
+			log.Fatalf("failed to load signing keys from %s: %v", cfg.KeysDir, err)
+		}
+		return keySet
+	}
+
+	log.Println("JWT_KEYS_DIR is not set, generating an ephemeral signing key")
+	key, err := keys.Generate("ephemeral", keys.AlgorithmES256)
+	if err != nil {
+		log.Fatalf("failed to generate signing key: %v", err)
+	}
+	keySet, err := keys.NewKeySet([]*keys.Key{key}, "")
+	if err != nil {
+		log.Fatalf("failed to build key set: %v", err)
+	}
+	return keySet
+}
+
+// newSMSSender picks where text messages go. No SMS provider is built in
+// yet, and the log and file senders only suit development and tests, so
+// without one no message is sent.
+func newSMSSender(cfg config.SMS) sms.SMSSender {
+	switch cfg.Sender {
+	case config.SMSSenderLog:
+		return sms.LogSender{}
+	case config.SMSSenderFile:
+		return sms.NewFileSender(cfg.File)
+	}
+	log.Println("WARNING: SMS_SENDER is not set, so no verification or password reset code can be texted")
+	return sms.NoSender{}
+}
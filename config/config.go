@@ -0,0 +1,596 @@
+// Package config loads the settings of the service. Every setting has a
+// default, can be set in the optional YAML file named by CONFIG_FILE, and
+// is overridden by its environment variable. The settings are validated
+// once, at startup, and printed with their secrets redacted.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/passwordhash"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/SawitProRecruitment/UserService/phone"
+	"github.com/SawitProRecruitment/UserService/ratelimit"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every setting of the service. Fields are read from the YAML
+// key in their yaml tag and from the environment variable in their env tag.
+// The env tag of a struct field prefixes the variables of its fields.
+// Fields tagged secret are redacted when printed.
+type Config struct {
+	Server     Server     `yaml:"server"`
+	Database   Database   `yaml:"database"`
+	Tokens     Tokens     `yaml:"tokens"`
+	MFA        MFA        `yaml:"mfa"`
+	RateLimits RateLimits `yaml:"rate_limits"`
+	Phone      Phone      `yaml:"phone"`
+	SMS        SMS        `yaml:"sms"`
+	Password   Password   `yaml:"password"`
+	Profile    Profile    `yaml:"profile"`
+}
+
+type Server struct {
+	Addr string `yaml:"addr" env:"LISTEN_ADDR"`
+	// TrustProxy reads the client IP from X-Forwarded-For, which only a
+	// proxy in front of the service may set.
+	TrustProxy bool `yaml:"trust_proxy" env:"TRUST_PROXY"`
+	// ValidateResponses checks the responses against api.yml, which is
+	// meant for development and test environments.
+	ValidateResponses bool `yaml:"validate_responses" env:"VALIDATE_RESPONSES"`
+	// ReadHeaderTimeout, ReadTimeout, WriteTimeout and IdleTimeout bound
+	// each phase of a connection, so that slow clients cannot hold it open.
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"READ_HEADER_TIMEOUT"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"READ_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"IDLE_TIMEOUT"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes" env:"MAX_HEADER_BYTES"`
+	MaxBodyBytes      int64         `yaml:"max_body_bytes" env:"MAX_BODY_BYTES"`
+	// ShutdownTimeout is how long in-flight requests may take to finish
+	// once the service is asked to stop.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// ReadinessTimeout bounds each check of /readyz.
+	ReadinessTimeout time.Duration `yaml:"readiness_timeout" env:"READINESS_TIMEOUT"`
+	// Metrics serves /metrics to Prometheus. It is not authenticated, so
+	// it is off unless turned on where the path cannot be reached from
+	// outside the network.
+	Metrics bool `yaml:"metrics" env:"METRICS"`
+	TLS     TLS  `yaml:"tls" env:"TLS"`
+}
+
+// TLS serves HTTPS when both files are set.
+type TLS struct {
+	CertFile string `yaml:"cert_file" env:"CERT_FILE"`
+	KeyFile  string `yaml:"key_file" env:"KEY_FILE"`
+	// ReloadInterval is how often the files are checked for a renewed
+	// certificate. Zero only reloads them on SIGHUP.
+	ReloadInterval time.Duration `yaml:"reload_interval" env:"RELOAD_INTERVAL"`
+}
+
+// Enabled reports whether HTTPS is served.
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" && t.KeyFile != ""
+}
+
+type Database struct {
+	URL         string `yaml:"url" env:"DATABASE_URL" secret:"true"`
+	AutoMigrate bool   `yaml:"auto_migrate" env:"AUTO_MIGRATE"`
+	// MaxOpenConns, MaxIdleConns and ConnMaxLifetime tune the connection
+	// pool. Zero keeps the database/sql default.
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"`
+	// ConnectAttempts is how many times the database is pinged at startup
+	// before giving up, waiting ConnectBackoff after the first failure and
+	// twice as long after each next one, up to ConnectMaxBackoff. One fails
+	// fast.
+	ConnectAttempts   int           `yaml:"connect_attempts" env:"DB_CONNECT_ATTEMPTS"`
+	ConnectBackoff    time.Duration `yaml:"connect_backoff" env:"DB_CONNECT_BACKOFF"`
+	ConnectMaxBackoff time.Duration `yaml:"connect_max_backoff" env:"DB_CONNECT_MAX_BACKOFF"`
+}
+
+type Tokens struct {
+	// KeysDir holds the signing keys. Without it an ephemeral key is
+	// generated, and tokens stop verifying after a restart.
+	KeysDir         string        `yaml:"keys_dir" env:"JWT_KEYS_DIR"`
+	SigningKeyID    string        `yaml:"signing_key_id" env:"JWT_SIGNING_KEY_ID"`
+	AccessTTL       time.Duration `yaml:"access_ttl" env:"ACCESS_TOKEN_TTL"`
+	RefreshTTL      time.Duration `yaml:"refresh_ttl" env:"REFRESH_TOKEN_TTL"`
+	RevocationStore string        `yaml:"revocation_store" env:"REVOCATION_STORE"`
+}
+
+type MFA struct {
+	TOTPIssuer string `yaml:"totp_issuer" env:"TOTP_ISSUER"`
+}
+
+// RateLimits are the limits of the routes that guess credentials.
+type RateLimits struct {
+	Store                    string    `yaml:"store" env:"RATE_LIMIT_STORE"`
+	Login                    RateLimit `yaml:"login" env:"RATE_LIMIT_LOGIN"`
+	LoginMFA                 RateLimit `yaml:"login_mfa" env:"RATE_LIMIT_LOGIN_MFA"`
+	Signup                   RateLimit `yaml:"signup" env:"RATE_LIMIT_SIGNUP"`
+	PasswordForgot           RateLimit `yaml:"password_forgot" env:"RATE_LIMIT_PASSWORD_FORGOT"`
+	PasswordReset            RateLimit `yaml:"password_reset" env:"RATE_LIMIT_PASSWORD_RESET"`
+	PhoneVerification        RateLimit `yaml:"phone_verification" env:"RATE_LIMIT_PHONE_VERIFICATION"`
+	PhoneVerificationConfirm RateLimit `yaml:"phone_verification_confirm" env:"RATE_LIMIT_PHONE_VERIFICATION_CONFIRM"`
+	UpdateMyProfile          RateLimit `yaml:"update_my_profile" env:"RATE_LIMIT_UPDATE_MY_PROFILE"`
+	MyPhoneConfirm           RateLimit `yaml:"my_phone_confirm" env:"RATE_LIMIT_MY_PHONE_CONFIRM"`
+}
+
+// RateLimit limits a route per client IP and per phone number, written as
+// "<requests>/<duration>" or "off".
+type RateLimit struct {
+	IP    string `yaml:"ip" env:"IP"`
+	Phone string `yaml:"phone" env:"PHONE"`
+}
+
+type Phone struct {
+	// DefaultRegion is the country of phone numbers written without a
+	// country code, an ISO 3166-1 code such as "ID".
+	DefaultRegion string `yaml:"default_region" env:"PHONE_DEFAULT_REGION"`
+}
+
+type SMS struct {
+	// Sender is where text messages go, SMSSenderLog or SMSSenderFile. Both
+	// only suit development and tests, as whoever reads them can use the
+	// codes, so none is chosen by default and no message is sent.
+	Sender string `yaml:"sender" env:"SMS_SENDER"`
+	// File is the file SMSSenderFile appends text messages to.
+	File string `yaml:"file" env:"SMS_FILE"`
+}
+
+type Password struct {
+	// History is how many of the latest passwords of a user cannot be
+	// chosen again, counting the current one. Zero lets any password be
+	// reused.
+	History int             `yaml:"history" env:"PASSWORD_HISTORY"`
+	Policy  PasswordPolicy  `yaml:"policy"`
+	Hashing PasswordHashing `yaml:"hashing"`
+	// BreachedFile adds a corpus of SHA-1 hashes to the built-in list of
+	// common passwords. "off" checks no password at all.
+	BreachedFile string `yaml:"breached_file" env:"BREACHED_PASSWORDS_FILE"`
+}
+
+type PasswordPolicy struct {
+	MinLength int `yaml:"min_length" env:"PASSWORD_MIN_LENGTH"`
+	MaxLength int `yaml:"max_length" env:"PASSWORD_MAX_LENGTH"`
+	// Require lists the classes of characters a password must contain:
+	// upper, lower, digit and symbol. "none" requires none.
+	Require []string `yaml:"require" env:"PASSWORD_REQUIRE"`
+	// MaxRepeated is the longest run of one character. Zero allows any run.
+	MaxRepeated      int      `yaml:"max_repeated" env:"PASSWORD_MAX_REPEATED"`
+	BanPersonalInfo  bool     `yaml:"ban_personal_info" env:"PASSWORD_BAN_PERSONAL_INFO"`
+	BannedSubstrings []string `yaml:"banned_substrings" env:"PASSWORD_BANNED_SUBSTRINGS"`
+}
+
+type PasswordHashing struct {
+	Algorithm         string `yaml:"algorithm" env:"PASSWORD_HASH_ALGORITHM"`
+	BcryptCost        int    `yaml:"bcrypt_cost" env:"PASSWORD_HASH_BCRYPT_COST"`
+	Argon2Memory      uint32 `yaml:"argon2_memory" env:"PASSWORD_HASH_ARGON2_MEMORY"`
+	Argon2Iterations  uint32 `yaml:"argon2_iterations" env:"PASSWORD_HASH_ARGON2_ITERATIONS"`
+	Argon2Parallelism uint8  `yaml:"argon2_parallelism" env:"PASSWORD_HASH_ARGON2_PARALLELISM"`
+}
+
+type Profile struct {
+	FullNameMinLength int `yaml:"full_name_min_length" env:"FULL_NAME_MIN_LENGTH"`
+	FullNameMaxLength int `yaml:"full_name_max_length" env:"FULL_NAME_MAX_LENGTH"`
+}
+
+// Stores of revocations and rate limits.
+const (
+	StorePostgres = "postgres"
+	StoreMemory   = "memory"
+)
+
+// Senders of text messages.
+const (
+	SMSSenderLog  = "log"
+	SMSSenderFile = "file"
+)
+
+// fullNameColumnSize is the size of the full_name column, which full names
+// cannot outgrow.
+const fullNameColumnSize = 60
+
+// defaultFullNameMinLength keeps the shortest full name there always was.
+const defaultFullNameMinLength = 3
+
+// Default returns the settings used when neither the file nor the
+// environment sets them.
+func Default() Config {
+	hashing := passwordhash.DefaultPolicy
+	policy := passwordpolicy.DefaultPolicy
+	c := Config{
+		Server: Server{
+			Addr:              ":1323",
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			MaxHeaderBytes:    64 << 10,
+			MaxBodyBytes:      1 << 20,
+			ShutdownTimeout:   20 * time.Second,
+			ReadinessTimeout:  2 * time.Second,
+			TLS: TLS{
+				ReloadInterval: time.Minute,
+			},
+		},
+		Database: Database{
+			AutoMigrate:       true,
+			ConnectAttempts:   1,
+			ConnectBackoff:    time.Second,
+			ConnectMaxBackoff: 30 * time.Second,
+		},
+		Tokens: Tokens{
+			AccessTTL:       15 * time.Minute,
+			RefreshTTL:      30 * 24 * time.Hour,
+			RevocationStore: StorePostgres,
+		},
+		MFA: MFA{
+			TOTPIssuer: "UserService",
+		},
+		RateLimits: RateLimits{
+			Store: StorePostgres,
+		},
+		Phone: Phone{
+			DefaultRegion: "ID",
+		},
+		Password: Password{
+			History: 5,
+			Policy: PasswordPolicy{
+				MinLength:        policy.MinLength,
+				MaxLength:        policy.MaxLength,
+				Require:          requiredClasses(policy),
+				MaxRepeated:      policy.MaxRepeated,
+				BanPersonalInfo:  policy.BanPersonalInfo,
+				BannedSubstrings: policy.BannedSubstrings,
+			},
+			Hashing: PasswordHashing{
+				Algorithm:         string(hashing.Algorithm),
+				BcryptCost:        hashing.BcryptCost,
+				Argon2Memory:      hashing.Argon2id.Memory,
+				Argon2Iterations:  hashing.Argon2id.Iterations,
+				Argon2Parallelism: hashing.Argon2id.Parallelism,
+			},
+		},
+		Profile: Profile{
+			FullNameMinLength: defaultFullNameMinLength,
+			FullNameMaxLength: fullNameColumnSize,
+		},
+	}
+	for route, limit := range c.RateLimits.routes() {
+		rule := ratelimit.Defaults[route]
+		*limit = RateLimit{IP: rule.PerIP.String(), Phone: rule.PerPhone.String()}
+	}
+	return c
+}
+
+// Load returns the settings of the service: the defaults, overridden by the
+// YAML file named by CONFIG_FILE, overridden by the environment.
+func Load() (Config, error) {
+	return load(os.Getenv("CONFIG_FILE"), os.Getenv)
+}
+
+func load(path string, getenv func(string) string) (Config, error) {
+	c := Default()
+	if path != "" {
+		if err := c.readFile(path); err != nil {
+			return Config{}, err
+		}
+	}
+	if err := readEnv(reflect.ValueOf(&c).Elem(), "", getenv); err != nil {
+		return Config{}, err
+	}
+	if err := c.Validate(); err != nil {
+		return Config{}, err
+	}
+	return c, nil
+}
+
+// readFile overrides c with the settings of a YAML file. Unknown keys are
+// rejected, so that a misspelt setting does not go unnoticed.
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && err != io.EOF {
+		return fmt.Errorf("config: %s: %w", path, err)
+	}
+	return nil
+}
+
+// readEnv overrides the fields of v with the environment variables named by
+// their env tags. Empty variables are ignored.
+func readEnv(v reflect.Value, prefix string, getenv func(string) string) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("env")
+		if name != "" && prefix != "" {
+			name = prefix + "_" + name
+		}
+		if field.Type.Kind() == reflect.Struct {
+			if err := readEnv(v.Field(i), name, getenv); err != nil {
+				return err
+			}
+			continue
+		}
+		if name == "" {
+			continue
+		}
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+		if err := set(v.Field(i), value); err != nil {
+			if field.Tag.Get("secret") != "" {
+				return fmt.Errorf("config: invalid %s", name)
+			}
+			return fmt.Errorf("config: invalid %s %q: %v", name, value, err)
+		}
+	}
+	return nil
+}
+
+// set parses s into v.
+func set(v reflect.Value, s string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return errors.New("must be true or false")
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("must be a number")
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a number between 0 and %d", uint64(1)<<v.Type().Bits()-1)
+		}
+		v.SetUint(n)
+	case reflect.Slice:
+		// Lists are comma separated.
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// Validate reports every setting the service cannot start with.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("config: "+format, args...))
+		}
+	}
+
+	_, _, err := net.SplitHostPort(c.Server.Addr)
+	check(err == nil, "server.addr %q must be a host and port, such as \":1323\"", c.Server.Addr)
+
+	check(c.Server.ReadHeaderTimeout > 0, "server.read_header_timeout must be positive")
+	check(c.Server.ReadTimeout > 0, "server.read_timeout must be positive")
+	check(c.Server.WriteTimeout > 0, "server.write_timeout must be positive")
+	check(c.Server.IdleTimeout > 0, "server.idle_timeout must be positive")
+	check(c.Server.MaxHeaderBytes > 0, "server.max_header_bytes must be positive")
+	check(c.Server.MaxBodyBytes > 0, "server.max_body_bytes must be positive")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout must be positive")
+	check((c.Server.TLS.CertFile == "") == (c.Server.TLS.KeyFile == ""), "server.tls.cert_file and server.tls.key_file must be set together")
+	check(c.Server.ReadinessTimeout > 0, "server.readiness_timeout must be positive")
+	check(c.Server.TLS.ReloadInterval >= 0, "server.tls.reload_interval must not be negative")
+
+	check(c.Database.MaxOpenConns >= 0, "database.max_open_conns must not be negative")
+	check(c.Database.MaxIdleConns >= 0, "database.max_idle_conns must not be negative")
+	check(c.Database.ConnMaxLifetime >= 0, "database.conn_max_lifetime must not be negative")
+	check(c.Database.ConnectAttempts >= 1, "database.connect_attempts must be at least 1")
+	check(c.Database.ConnectBackoff > 0, "database.connect_backoff must be positive")
+	check(c.Database.ConnectMaxBackoff >= c.Database.ConnectBackoff, "database.connect_max_backoff must be at least database.connect_backoff")
+
+	check(c.Tokens.AccessTTL > 0, "tokens.access_ttl must be positive")
+	check(c.Tokens.RefreshTTL > 0, "tokens.refresh_ttl must be positive")
+	check(validStore(c.Tokens.RevocationStore), "tokens.revocation_store %q must be %s or %s", c.Tokens.RevocationStore, StorePostgres, StoreMemory)
+	check(c.Tokens.SigningKeyID == "" || c.Tokens.KeysDir != "", "tokens.signing_key_id needs tokens.keys_dir")
+
+	check(c.MFA.TOTPIssuer != "", "mfa.totp_issuer must not be empty")
+
+	check(validStore(c.RateLimits.Store), "rate_limits.store %q must be %s or %s", c.RateLimits.Store, StorePostgres, StoreMemory)
+	if _, err := c.RateLimits.Rules(); err != nil {
+		errs = append(errs, err)
+	}
+
+	check(c.SMS.Sender == "" || c.SMS.Sender == SMSSenderLog || c.SMS.Sender == SMSSenderFile, "sms.sender %q must be %s or %s", c.SMS.Sender, SMSSenderLog, SMSSenderFile)
+	check(c.SMS.Sender != SMSSenderFile || c.SMS.File != "", "sms.sender %s needs sms.file", SMSSenderFile)
+	check(c.SMS.File == "" || c.SMS.Sender == SMSSenderFile, "sms.file needs sms.sender %s", SMSSenderFile)
+
+	check(phone.KnownRegion(c.Phone.DefaultRegion), "phone.default_region %q is not a supported country", c.Phone.DefaultRegion)
+
+	check(c.Password.History >= 0, "password.history must not be negative")
+	for _, class := range c.Password.Policy.Require {
+		_, ok := requireClasses[class]
+		check(ok || class == "none", "password.policy.require %q must be upper, lower, digit, symbol or none", class)
+	}
+	if err := c.Password.Policy.Policy().Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("config: password.policy: %w", err))
+	}
+	if err := c.Password.Hashing.Policy().Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("config: password.hashing: %w", err))
+	}
+
+	check(c.Profile.FullNameMinLength >= 1, "profile.full_name_min_length must be at least 1")
+	check(c.Profile.FullNameMaxLength >= c.Profile.FullNameMinLength, "profile.full_name_max_length must be at least profile.full_name_min_length")
+	check(c.Profile.FullNameMaxLength <= fullNameColumnSize, "profile.full_name_max_length must be at most %d, the size of the full_name column", fullNameColumnSize)
+
+	return errors.Join(errs...)
+}
+
+func validStore(store string) bool {
+	return store == StorePostgres || store == StoreMemory
+}
+
+// routes pairs the routes of ratelimit.Defaults with the fields that
+// limit them.
+func (r *RateLimits) routes() map[string]*RateLimit {
+	return map[string]*RateLimit{
+		"POST /login":                      &r.Login,
+		"POST /login/mfa":                  &r.LoginMFA,
+		"POST /signup":                     &r.Signup,
+		"POST /password/forgot":            &r.PasswordForgot,
+		"POST /password/reset":             &r.PasswordReset,
+		"POST /phone-verification":         &r.PhoneVerification,
+		"POST /phone-verification/confirm": &r.PhoneVerificationConfirm,
+		"PATCH /update-my-profile":         &r.UpdateMyProfile,
+		"POST /my-phone/confirm":           &r.MyPhoneConfirm,
+	}
+}
+
+// Rules returns the rate limits of every route, keyed like
+// ratelimit.Defaults.
+func (r RateLimits) Rules() (map[string]ratelimit.Rule, error) {
+	rules := make(map[string]ratelimit.Rule)
+	for route, rule := range ratelimit.Defaults {
+		rules[route] = rule
+	}
+	for route, limit := range r.routes() {
+		perIP, err := ratelimit.Parse(limit.IP)
+		if err != nil {
+			return nil, fmt.Errorf("config: rate_limits of %s: %w", route, err)
+		}
+		perPhone, err := ratelimit.Parse(limit.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("config: rate_limits of %s: %w", route, err)
+		}
+		rules[route] = ratelimit.Rule{PerIP: perIP, PerPhone: perPhone}
+	}
+	return rules, nil
+}
+
+// requireClasses sets the rule of each class of characters in
+// PasswordPolicy.Require.
+var requireClasses = map[string]func(p *passwordpolicy.Policy) *bool{
+	"upper":  func(p *passwordpolicy.Policy) *bool { return &p.RequireUpper },
+	"lower":  func(p *passwordpolicy.Policy) *bool { return &p.RequireLower },
+	"digit":  func(p *passwordpolicy.Policy) *bool { return &p.RequireDigit },
+	"symbol": func(p *passwordpolicy.Policy) *bool { return &p.RequireSymbol },
+}
+
+func requiredClasses(p passwordpolicy.Policy) []string {
+	var classes []string
+	for _, class := range []string{"upper", "lower", "digit", "symbol"} {
+		if *requireClasses[class](&p) {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// Policy returns the password policy. Unknown classes of characters are
+// reported by Validate.
+func (p PasswordPolicy) Policy() passwordpolicy.Policy {
+	policy := passwordpolicy.Policy{
+		MinLength:        p.MinLength,
+		MaxLength:        p.MaxLength,
+		MaxRepeated:      p.MaxRepeated,
+		BanPersonalInfo:  p.BanPersonalInfo,
+		BannedSubstrings: p.BannedSubstrings,
+	}
+	for _, class := range p.Require {
+		if rule, ok := requireClasses[class]; ok {
+			*rule(&policy) = true
+		}
+	}
+	return policy
+}
+
+// Policy returns how new password hashes are made.
+func (p PasswordHashing) Policy() passwordhash.Policy {
+	policy := passwordhash.DefaultPolicy
+	policy.Algorithm = passwordhash.Algorithm(p.Algorithm)
+	policy.BcryptCost = p.BcryptCost
+	policy.Argon2id.Memory = p.Argon2Memory
+	policy.Argon2id.Iterations = p.Argon2Iterations
+	policy.Argon2id.Parallelism = p.Argon2Parallelism
+	return policy
+}
+
+// String returns the settings as YAML, with the secrets redacted, so that
+// they can be logged.
+func (c Config) String() string {
+	redacted := c
+	redact(reflect.ValueOf(&redacted).Elem())
+	out, err := yaml.Marshal(redacted)
+	if err != nil {
+		return fmt.Sprintf("config: %v", err)
+	}
+	return string(out)
+}
+
+// GoString redacts the secrets of %#v too.
+func (c Config) GoString() string {
+	return c.String()
+}
+
+func redact(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			redact(v.Field(i))
+			continue
+		}
+		if field.Tag.Get("secret") != "" && field.Type.Kind() == reflect.String {
+			v.Field(i).SetString(redactSecret(v.Field(i).String()))
+		}
+	}
+}
+
+// redactSecret hides a secret. The password of a URL is hidden, keeping
+// the rest of it readable, and any other secret is hidden whole.
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	u, err := url.Parse(secret)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "REDACTED"
+	}
+	if query := u.Query(); query.Has("password") {
+		query.Set("password", "xxxxx")
+		u.RawQuery = query.Encode()
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,269 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/passwordhash"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/SawitProRecruitment/UserService/ratelimit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func env(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func Test_Default(t *testing.T) {
+	c := Default()
+	assert.NoError(t, c.Validate())
+	// The defaults keep the behavior of a server built with no options.
+	assert.Equal(t, passwordhash.DefaultPolicy, c.Password.Hashing.Policy())
+	assert.Equal(t, passwordpolicy.DefaultPolicy, c.Password.Policy.Policy())
+	assert.Equal(t, Profile{FullNameMinLength: 3, FullNameMaxLength: 60}, c.Profile)
+	// /metrics is not authenticated, so it is only served when asked for.
+	assert.False(t, c.Server.Metrics)
+	rules, err := c.RateLimits.Rules()
+	assert.NoError(t, err)
+	assert.Equal(t, ratelimit.Defaults, rules)
+}
+
+func Test_load(t *testing.T) {
+	file := writeFile(t, `
+server:
+  addr: ":8080"
+database:
+  url: postgres://file@db/users
+  max_open_conns: 10
+tokens:
+  access_ttl: 5m
+rate_limits:
+  login:
+    ip: 30/1m
+password:
+  policy:
+    require: [lower]
+`)
+	c, err := load(file, env(map[string]string{
+		"DATABASE_URL":                     "postgres://env@db/users",
+		"AUTO_MIGRATE":                     "false",
+		"RATE_LIMIT_LOGIN_PHONE":           "off",
+		"PASSWORD_BANNED_SUBSTRINGS":       "sawit, pro",
+		"PASSWORD_HASH_ARGON2_PARALLELISM": "4",
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, ":8080", c.Server.Addr)
+	assert.Equal(t, "postgres://env@db/users", c.Database.URL)
+	assert.False(t, c.Database.AutoMigrate)
+	assert.Equal(t, 10, c.Database.MaxOpenConns)
+	assert.Equal(t, 5*time.Minute, c.Tokens.AccessTTL)
+	assert.Equal(t, 30*24*time.Hour, c.Tokens.RefreshTTL)
+	assert.Equal(t, RateLimit{IP: "30/1m", Phone: "off"}, c.RateLimits.Login)
+	assert.Equal(t, []string{"lower"}, c.Password.Policy.Require)
+	assert.Equal(t, []string{"sawit", "pro"}, c.Password.Policy.BannedSubstrings)
+	assert.Equal(t, uint8(4), c.Password.Hashing.Argon2Parallelism)
+
+	rules, err := c.RateLimits.Rules()
+	assert.NoError(t, err)
+	assert.Equal(t, ratelimit.Rule{PerIP: ratelimit.Limit{Requests: 30, Per: time.Minute}}, rules["POST /login"])
+	assert.Equal(t, ratelimit.Defaults["POST /signup"], rules["POST /signup"])
+}
+
+func Test_load_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		err  string
+	}{
+		{
+			name: "unknown key",
+			file: "server:\n  adress: \":8080\"\n",
+			err:  "field adress not found",
+		},
+		{
+			name: "invalid number",
+			env:  map[string]string{"PASSWORD_HISTORY": "five"},
+			err:  `config: invalid PASSWORD_HISTORY "five": must be a number`,
+		},
+		{
+			name: "out of range",
+			env:  map[string]string{"PASSWORD_HASH_ARGON2_PARALLELISM": "300"},
+			err:  `config: invalid PASSWORD_HASH_ARGON2_PARALLELISM "300": must be a number between 0 and 255`,
+		},
+		{
+			name: "invalid duration",
+			env:  map[string]string{"ACCESS_TOKEN_TTL": "soon"},
+			err:  `config: invalid ACCESS_TOKEN_TTL "soon"`,
+		},
+		{
+			name: "invalid bool",
+			env:  map[string]string{"TRUST_PROXY": "yes please"},
+			err:  `config: invalid TRUST_PROXY "yes please": must be true or false`,
+		},
+		{
+			name: "invalid setting",
+			env:  map[string]string{"REVOCATION_STORE": "redis"},
+			err:  `config: tokens.revocation_store "redis" must be postgres or memory`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var path string
+			if test.file != "" {
+				path = writeFile(t, test.file)
+			}
+			_, err := load(path, env(test.env))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		errs   []string
+	}{
+		{
+			name:   "defaults",
+			modify: func(c *Config) {},
+		},
+		{
+			name: "every broken setting is reported",
+			modify: func(c *Config) {
+				c.Server.Addr = "1323"
+				c.Tokens.AccessTTL = 0
+				c.Tokens.SigningKeyID = "key-1"
+				c.RateLimits.Store = "redis"
+				c.RateLimits.Signup.IP = "5 per minute"
+				c.Phone.DefaultRegion = "XX"
+				c.Password.Policy.Require = []string{"emoji"}
+				c.Password.Hashing.BcryptCost = 99
+				c.Password.Hashing.Algorithm = "bcrypt"
+				c.Profile.FullNameMaxLength = 80
+			},
+			errs: []string{
+				`config: server.addr "1323" must be a host and port, such as ":1323"`,
+				"config: tokens.access_ttl must be positive",
+				"config: tokens.signing_key_id needs tokens.keys_dir",
+				`config: rate_limits.store "redis" must be postgres or memory`,
+				`config: rate_limits of POST /signup: rate limit "5 per minute" is not in the form <requests>/<duration>`,
+				`config: phone.default_region "XX" is not a supported country`,
+				`config: password.policy.require "emoji" must be upper, lower, digit, symbol or none`,
+				"config: password.hashing: passwordhash: bcrypt cost must be between 4 and 31",
+				"config: profile.full_name_max_length must be at most 60, the size of the full_name column",
+			},
+		},
+		{
+			name: "no required classes",
+			modify: func(c *Config) {
+				c.Password.Policy.Require = []string{"none"}
+			},
+		},
+		{
+			name: "tls needs both files",
+			modify: func(c *Config) {
+				c.Server.TLS.CertFile = "tls.crt"
+				c.Server.ShutdownTimeout = 0
+			},
+			errs: []string{
+				"config: server.shutdown_timeout must be positive",
+				"config: server.tls.cert_file and server.tls.key_file must be set together",
+			},
+		},
+		{
+			name: "sms file",
+			modify: func(c *Config) {
+				c.SMS.Sender = SMSSenderFile
+				c.SMS.File = "sms.log"
+			},
+		},
+		{
+			name: "sms sender",
+			modify: func(c *Config) {
+				c.SMS.Sender = "carrier pigeon"
+			},
+			errs: []string{`config: sms.sender "carrier pigeon" must be log or file`},
+		},
+		{
+			name: "sms file needs the file sender",
+			modify: func(c *Config) {
+				c.SMS.File = "sms.log"
+			},
+			errs: []string{"config: sms.file needs sms.sender file"},
+		},
+		{
+			name: "password history off",
+			modify: func(c *Config) {
+				c.Password.History = 0
+			},
+		},
+		{
+			name: "negative password history",
+			modify: func(c *Config) {
+				c.Password.History = -1
+			},
+			errs: []string{"config: password.history must not be negative"},
+		},
+		{
+			name: "full name bounds",
+			modify: func(c *Config) {
+				c.Profile.FullNameMinLength = 10
+				c.Profile.FullNameMaxLength = 5
+			},
+			errs: []string{"config: profile.full_name_max_length must be at least profile.full_name_min_length"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Default()
+			test.modify(&c)
+			err := c.Validate()
+			if test.errs == nil {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Equal(t, test.errs, strings.Split(err.Error(), "\n"))
+			}
+		})
+	}
+}
+
+func Test_String(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "", want: `url: ""`},
+		{url: "postgres://postgres:s3cret@db:5432/database?sslmode=disable", want: "url: postgres://postgres:xxxxx@db:5432/database?sslmode=disable"},
+		{url: "postgres://db/database?password=s3cret", want: "url: postgres://db/database?password=xxxxx"},
+		{url: "host=db password=s3cret", want: "url: REDACTED"},
+	}
+	for _, test := range tests {
+		c := Default()
+		c.Database.URL = test.url
+		for _, out := range []string{c.String(), (&c).String(), c.GoString()} {
+			assert.Contains(t, out, test.want)
+			assert.NotContains(t, out, "s3cret")
+		}
+		// Printing does not change the config.
+		assert.Equal(t, test.url, c.Database.URL)
+	}
+}
@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package generated
 
 import (
@@ -12,15 +12,185 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 )
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Message string `json:"message"`
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for JSONWebKeyAlg.
+const (
+	ES256 JSONWebKeyAlg = "ES256"
+	RS256 JSONWebKeyAlg = "RS256"
+)
+
+// Defines values for JSONWebKeyKty.
+const (
+	EC  JSONWebKeyKty = "EC"
+	RSA JSONWebKeyKty = "RSA"
+)
+
+// Defines values for Language.
+const (
+	En Language = "en"
+	Id Language = "id"
+)
+
+// Defines values for MfaChallengeStatus.
+const (
+	MfaRequired MfaChallengeStatus = "mfa_required"
+)
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword      string `json:"current_password"`
+	NewPassword          string `json:"new_password"`
+	SignOutOtherSessions *bool  `json:"sign_out_other_sessions,omitempty"`
+}
+
+// CodeRequest defines model for CodeRequest.
+type CodeRequest struct {
+	Code string `json:"code"`
+}
+
+// JSONWebKey defines model for JSONWebKey.
+type JSONWebKey struct {
+	Alg JSONWebKeyAlg `json:"alg"`
+	Crv *string       `json:"crv,omitempty"`
+	E   *string       `json:"e,omitempty"`
+	Kid string        `json:"kid"`
+	Kty JSONWebKeyKty `json:"kty"`
+	N   *string       `json:"n,omitempty"`
+	Use string        `json:"use"`
+	X   *string       `json:"x,omitempty"`
+	Y   *string       `json:"y,omitempty"`
+}
+
+// JSONWebKeyAlg defines model for JSONWebKey.Alg.
+type JSONWebKeyAlg string
+
+// JSONWebKeyKty defines model for JSONWebKey.Kty.
+type JSONWebKeyKty string
+
+// JSONWebKeySet defines model for JSONWebKeySet.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// Language Language of user-facing messages, as an ISO 639-1 code.
+type Language string
+
+// LockState defines model for LockState.
+type LockState struct {
+	// FailedLoginAttempts Consecutive failed logins since the last successful one.
+	FailedLoginAttempts int        `json:"failed_login_attempts"`
+	LastFailedLoginAt   *time.Time `json:"last_failed_login_at,omitempty"`
+	Locked              bool       `json:"locked"`
+	LockedUntil         *time.Time `json:"locked_until,omitempty"`
+	UserId              int        `json:"user_id"`
+}
+
+// LoginMfaRequest defines model for LoginMfaRequest.
+type LoginMfaRequest struct {
+	// Code Current code of the authenticator app or an unused recovery code.
+	Code     string `json:"code"`
+	MfaToken string `json:"mfa_token"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Password string `json:"password"`
+
+	// PhoneNumber Phone number with its country code, or without it for numbers of the default region.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// MfaChallenge defines model for MfaChallenge.
+type MfaChallenge struct {
+	// ExpiresIn Lifetime of the challenge token in seconds.
+	ExpiresIn int `json:"expires_in"`
+
+	// MfaToken Challenge token for /login/mfa. It cannot be used as an access token.
+	MfaToken string             `json:"mfa_token"`
+	Status   MfaChallengeStatus `json:"status"`
+}
+
+// MfaChallengeStatus defines model for MfaChallenge.Status.
+type MfaChallengeStatus string
+
+// PasswordResetRequest defines model for PasswordResetRequest.
+type PasswordResetRequest struct {
+	Code        string `json:"code"`
+	NewPassword string `json:"new_password"`
+
+	// PhoneNumber Phone number with its country code, or without it for numbers of the default region.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// PhoneCodeRequest defines model for PhoneCodeRequest.
+type PhoneCodeRequest struct {
+	Code string `json:"code"`
+
+	// PhoneNumber Phone number with its country code, or without it for numbers of the default region.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// PhoneNumberRequest defines model for PhoneNumberRequest.
+type PhoneNumberRequest struct {
+	// PhoneNumber Phone number with its country code, or without it for numbers of the default region.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// Problem Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type Problem struct {
+	// Code Stable machine-readable reason for clients to branch on: invalid_request, validation_failed, malformed_body, unsupported_media_type, request_too_large, authorization_missing, token_invalid, token_revoked, insufficient_scope, invalid_credentials, account_locked, account_exists, profile_not_found, user_not_found, phone_number_taken, current_password_invalid, password_reused, refresh_token_invalid, mfa_challenge_invalid, mfa_code_invalid, mfa_already_enabled, mfa_not_enabled, mfa_enrollment_not_started, verification_code_invalid, verification_code_expired, verification_code_attempts_exceeded, verification_code_sent_recently, sms_delivery_failed, rate_limited, not_found, method_not_allowed or internal_error.
+	Code string `json:"code"`
+
+	// Detail Explanation of this occurrence of the problem, when the title is not enough.
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance Path of the request that failed.
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId ID of the request, also sent in the X-Request-Id header. Quote it when reporting a problem.
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Title Short summary of the kind of problem. It is the same for every occurrence of a code.
+	Title string `json:"title"`
+
+	// Type URI of the kind of problem, the code prefixed with urn:problem-type:user-service:.
+	Type string `json:"type"`
+
+	// Violations Every rule the fields of the request broke, present when code is validation_failed.
+	Violations *[]Violation `json:"violations,omitempty"`
+}
+
+// Profile defines model for Profile.
+type Profile struct {
+	// Language Language of user-facing messages, as an ISO 639-1 code.
+	Language *Language `json:"language,omitempty"`
+	Name     string    `json:"name"`
+
+	// PhoneNumber Phone number in E.164 format.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
 // Response defines model for Response.
@@ -28,6 +198,84 @@ type Response struct {
 	Message string `json:"message"`
 }
 
+// SignupRequest defines model for SignupRequest.
+type SignupRequest struct {
+	FullName string `json:"full_name"`
+	Password string `json:"password"`
+
+	// PhoneNumber Phone number with its country code, or without it for numbers of the default region.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// SignupResponse defines model for SignupResponse.
+type SignupResponse struct {
+	Id      int    `json:"id"`
+	Message string `json:"message"`
+}
+
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// Secret Base32 encoded TOTP secret.
+	Secret string `json:"secret"`
+}
+
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	// ExpiresIn Lifetime of the access token in seconds.
+	ExpiresIn int `json:"expires_in"`
+
+	// RefreshToken Single-use token for /token/refresh.
+	RefreshToken string `json:"refresh_token"`
+
+	// Token Access token to send as a bearer token.
+	Token     string `json:"token"`
+	TokenType string `json:"token_type"`
+}
+
+// UpdateProfileRequest defines model for UpdateProfileRequest.
+type UpdateProfileRequest struct {
+	FullName *string `json:"full_name,omitempty"`
+
+	// Language Language of user-facing messages, as an ISO 639-1 code.
+	Language *Language `json:"language,omitempty"`
+
+	// PhoneNumber Phone number with its country code, or without it for numbers of the default region.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+}
+
+// Violation defines model for Violation.
+type Violation struct {
+	// Code Stable code of the broken rule: invalid_phone_number, invalid_full_name, invalid_language, password_too_short, password_too_long, password_too_many_bytes, password_missing_uppercase, password_missing_lowercase, password_missing_digit, password_missing_symbol, password_repeated_characters, password_contains_personal_info, password_contains_banned_substring or password_breached.
+	Code string `json:"code"`
+
+	// Field Request field that broke the rule.
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// BadRequest Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type BadRequest = Problem
+
+// Conflict Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type Conflict = Problem
+
+// Locked Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type Locked = Problem
+
+// NotFound Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type NotFound = Problem
+
+// TooManyRequests Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type TooManyRequests = Problem
+
+// Unauthorized Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type Unauthorized = Problem
+
+// UnsupportedMediaType Problem details as defined by RFC 7807, sent with the application/problem+json media type by every error response.
+type UnsupportedMediaType = Problem
+
 // HelloParams defines parameters for Hello.
 type HelloParams struct {
 	Id string `form:"id" json:"id"`
@@ -35,40 +283,238 @@ type HelloParams struct {
 
 // PostLoginParams defines parameters for PostLogin.
 type PostLoginParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
 	PhoneNumber string `form:"phone_number" json:"phone_number"`
 	Password    string `form:"password" json:"password"`
 }
 
+// PostLoginMfaParams defines parameters for PostLoginMfa.
+type PostLoginMfaParams struct {
+	MfaToken string `form:"mfa_token" json:"mfa_token"`
+	Code     string `form:"code" json:"code"`
+}
+
+// DeleteMyTotpParams defines parameters for DeleteMyTotp.
+type DeleteMyTotpParams struct {
+	Code string `form:"code" json:"code"`
+}
+
+// PostMyTotpVerifyParams defines parameters for PostMyTotpVerify.
+type PostMyTotpVerifyParams struct {
+	Code string `form:"code" json:"code"`
+}
+
+// UpdateMyPasswordParams defines parameters for UpdateMyPassword.
+type UpdateMyPasswordParams struct {
+	CurrentPassword      string `form:"current_password" json:"current_password"`
+	NewPassword          string `form:"new_password" json:"new_password"`
+	SignOutOtherSessions *bool  `form:"sign_out_other_sessions,omitempty" json:"sign_out_other_sessions,omitempty"`
+}
+
+// PostMyPhoneConfirmParams defines parameters for PostMyPhoneConfirm.
+type PostMyPhoneConfirmParams struct {
+	Code string `form:"code" json:"code"`
+}
+
+// PostPasswordForgotParams defines parameters for PostPasswordForgot.
+type PostPasswordForgotParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
+	PhoneNumber string `form:"phone_number" json:"phone_number"`
+}
+
+// PostPasswordResetParams defines parameters for PostPasswordReset.
+type PostPasswordResetParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
+	PhoneNumber string `form:"phone_number" json:"phone_number"`
+	Code        string `form:"code" json:"code"`
+	NewPassword string `form:"new_password" json:"new_password"`
+}
+
+// PostPhoneVerificationParams defines parameters for PostPhoneVerification.
+type PostPhoneVerificationParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
+	PhoneNumber string `form:"phone_number" json:"phone_number"`
+}
+
+// PostPhoneVerificationConfirmParams defines parameters for PostPhoneVerificationConfirm.
+type PostPhoneVerificationConfirmParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
+	PhoneNumber string `form:"phone_number" json:"phone_number"`
+	Code        string `form:"code" json:"code"`
+}
+
 // PostSignupParams defines parameters for PostSignup.
 type PostSignupParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
 	PhoneNumber string `form:"phone_number" json:"phone_number"`
 	FullName    string `form:"full_name" json:"full_name"`
 	Password    string `form:"password" json:"password"`
 }
 
+// PostTokenRefreshParams defines parameters for PostTokenRefresh.
+type PostTokenRefreshParams struct {
+	RefreshToken string `form:"refresh_token" json:"refresh_token"`
+}
+
 // UpdateMyProfileParams defines parameters for UpdateMyProfile.
 type UpdateMyProfileParams struct {
+	// PhoneNumber Phone number with its country code, such as "+62 812-3456-789", or without it for numbers of the default region, such as "0812-3456-789". It is stored in E.164 format.
 	PhoneNumber *string `form:"phone_number,omitempty" json:"phone_number,omitempty"`
 	FullName    *string `form:"full_name,omitempty" json:"full_name,omitempty"`
+
+	// Language Language of the messages answered to the user, which takes precedence over the Accept-Language header of later requests. Applies to access tokens issued afterwards.
+	Language *Language `form:"language,omitempty" json:"language,omitempty"`
 }
 
+// PostV2LoginJSONRequestBody defines body for PostV2Login for application/json ContentType.
+type PostV2LoginJSONRequestBody = LoginRequest
+
+// PostV2LoginMfaJSONRequestBody defines body for PostV2LoginMfa for application/json ContentType.
+type PostV2LoginMfaJSONRequestBody = LoginMfaRequest
+
+// PatchV2MeJSONRequestBody defines body for PatchV2Me for application/json ContentType.
+type PatchV2MeJSONRequestBody = UpdateProfileRequest
+
+// PostV2MeTotpDisableJSONRequestBody defines body for PostV2MeTotpDisable for application/json ContentType.
+type PostV2MeTotpDisableJSONRequestBody = CodeRequest
+
+// PostV2MeTotpVerifyJSONRequestBody defines body for PostV2MeTotpVerify for application/json ContentType.
+type PostV2MeTotpVerifyJSONRequestBody = CodeRequest
+
+// PutV2MePasswordJSONRequestBody defines body for PutV2MePassword for application/json ContentType.
+type PutV2MePasswordJSONRequestBody = ChangePasswordRequest
+
+// PostV2MePhoneConfirmJSONRequestBody defines body for PostV2MePhoneConfirm for application/json ContentType.
+type PostV2MePhoneConfirmJSONRequestBody = CodeRequest
+
+// PostV2PasswordForgotJSONRequestBody defines body for PostV2PasswordForgot for application/json ContentType.
+type PostV2PasswordForgotJSONRequestBody = PhoneNumberRequest
+
+// PostV2PasswordResetJSONRequestBody defines body for PostV2PasswordReset for application/json ContentType.
+type PostV2PasswordResetJSONRequestBody = PasswordResetRequest
+
+// PostV2PhoneVerificationJSONRequestBody defines body for PostV2PhoneVerification for application/json ContentType.
+type PostV2PhoneVerificationJSONRequestBody = PhoneNumberRequest
+
+// PostV2PhoneVerificationConfirmJSONRequestBody defines body for PostV2PhoneVerificationConfirm for application/json ContentType.
+type PostV2PhoneVerificationConfirmJSONRequestBody = PhoneCodeRequest
+
+// PostV2SignupJSONRequestBody defines body for PostV2Signup for application/json ContentType.
+type PostV2SignupJSONRequestBody = SignupRequest
+
+// PostV2TokenRefreshJSONRequestBody defines body for PostV2TokenRefresh for application/json ContentType.
+type PostV2TokenRefreshJSONRequestBody = RefreshTokenRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// JSON Web Key Set
+	// (GET /.well-known/jwks.json)
+	GetJwks(ctx echo.Context) error
+	// Unlock Account
+	// (DELETE /admin/users/{id}/lock)
+	DeleteUserLock(ctx echo.Context, id int) error
+	// Get Account Lock State
+	// (GET /admin/users/{id}/lock)
+	GetUserLock(ctx echo.Context, id int) error
 	// This is just a test endpoint to get you started. Please delete this endpoint.
 	// (GET /hello)
 	Hello(ctx echo.Context, params HelloParams) error
 	// Login
 	// (POST /login)
 	PostLogin(ctx echo.Context, params PostLoginParams) error
+	// Complete Login With Second Factor
+	// (POST /login/mfa)
+	PostLoginMfa(ctx echo.Context, params PostLoginMfaParams) error
+	// Logout
+	// (POST /logout)
+	PostLogout(ctx echo.Context) error
+	// Logout From All Sessions
+	// (POST /logout-all)
+	PostLogoutAll(ctx echo.Context) error
+	// Disable TOTP
+	// (DELETE /my-mfa/totp)
+	DeleteMyTotp(ctx echo.Context, params DeleteMyTotpParams) error
+	// Start TOTP Enrollment
+	// (POST /my-mfa/totp)
+	PostMyTotp(ctx echo.Context) error
+	// Confirm TOTP Enrollment
+	// (POST /my-mfa/totp/verify)
+	PostMyTotpVerify(ctx echo.Context, params PostMyTotpVerifyParams) error
+	// Change My Password
+	// (PUT /my-password)
+	UpdateMyPassword(ctx echo.Context, params UpdateMyPasswordParams) error
+	// Confirm Phone Number Change
+	// (POST /my-phone/confirm)
+	PostMyPhoneConfirm(ctx echo.Context, params PostMyPhoneConfirmParams) error
 	// Get My Profile
 	// (GET /my-profile)
 	GetMyProfile(ctx echo.Context) error
+	// Forgot Password
+	// (POST /password/forgot)
+	PostPasswordForgot(ctx echo.Context, params PostPasswordForgotParams) error
+	// Reset Password
+	// (POST /password/reset)
+	PostPasswordReset(ctx echo.Context, params PostPasswordResetParams) error
+	// Request Phone Verification Code
+	// (POST /phone-verification)
+	PostPhoneVerification(ctx echo.Context, params PostPhoneVerificationParams) error
+	// Confirm Phone Number
+	// (POST /phone-verification/confirm)
+	PostPhoneVerificationConfirm(ctx echo.Context, params PostPhoneVerificationConfirmParams) error
 	// Sign up
 	// (POST /signup)
 	PostSignup(ctx echo.Context, params PostSignupParams) error
+	// Refresh Token
+	// (POST /token/refresh)
+	PostTokenRefresh(ctx echo.Context, params PostTokenRefreshParams) error
 	// Update My Profile
 	// (PATCH /update-my-profile)
 	UpdateMyProfile(ctx echo.Context, params UpdateMyProfileParams) error
+	// Login (v2)
+	// (POST /v2/login)
+	PostV2Login(ctx echo.Context) error
+	// Complete Login With Second Factor (v2)
+	// (POST /v2/login/mfa)
+	PostV2LoginMfa(ctx echo.Context) error
+	// Get My Profile (v2)
+	// (GET /v2/me)
+	GetV2Me(ctx echo.Context) error
+	// Update My Profile (v2)
+	// (PATCH /v2/me)
+	PatchV2Me(ctx echo.Context) error
+	// Start TOTP Enrollment (v2)
+	// (POST /v2/me/mfa/totp)
+	PostV2MeTotp(ctx echo.Context) error
+	// Disable TOTP (v2)
+	// (POST /v2/me/mfa/totp/disable)
+	PostV2MeTotpDisable(ctx echo.Context) error
+	// Confirm TOTP Enrollment (v2)
+	// (POST /v2/me/mfa/totp/verify)
+	PostV2MeTotpVerify(ctx echo.Context) error
+	// Change My Password (v2)
+	// (PUT /v2/me/password)
+	PutV2MePassword(ctx echo.Context) error
+	// Confirm New Phone Number (v2)
+	// (POST /v2/me/phone/confirm)
+	PostV2MePhoneConfirm(ctx echo.Context) error
+	// Forgot Password (v2)
+	// (POST /v2/password/forgot)
+	PostV2PasswordForgot(ctx echo.Context) error
+	// Reset Password (v2)
+	// (POST /v2/password/reset)
+	PostV2PasswordReset(ctx echo.Context) error
+	// Request Phone Verification Code (v2)
+	// (POST /v2/phone-verification)
+	PostV2PhoneVerification(ctx echo.Context) error
+	// Confirm Phone Number (v2)
+	// (POST /v2/phone-verification/confirm)
+	PostV2PhoneVerificationConfirm(ctx echo.Context) error
+	// Sign up (v2)
+	// (POST /v2/signup)
+	PostV2Signup(ctx echo.Context) error
+	// Refresh Token (v2)
+	// (POST /v2/token/refresh)
+	PostV2TokenRefresh(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -76,6 +522,51 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetJwks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJwks(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJwks(ctx)
+	return err
+}
+
+// DeleteUserLock converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUserLock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUserLock(ctx, id)
+	return err
+}
+
+// GetUserLock converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserLock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserLock(ctx, id)
+	return err
+}
+
 // Hello converts echo context to params.
 func (w *ServerInterfaceWrapper) Hello(ctx echo.Context) error {
 	var err error
@@ -119,15 +610,262 @@ func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
 	return err
 }
 
+// PostLoginMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLoginMfa(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLoginMfaParams
+	// ------------- Required query parameter "mfa_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "mfa_token", ctx.QueryParams(), &params.MfaToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mfa_token: %s", err))
+	}
+
+	// ------------- Required query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLoginMfa(ctx, params)
+	return err
+}
+
+// PostLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogout(ctx)
+	return err
+}
+
+// PostLogoutAll converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogoutAll(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogoutAll(ctx)
+	return err
+}
+
+// DeleteMyTotp converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMyTotp(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMyTotpParams
+	// ------------- Required query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMyTotp(ctx, params)
+	return err
+}
+
+// PostMyTotp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMyTotp(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostMyTotp(ctx)
+	return err
+}
+
+// PostMyTotpVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMyTotpVerify(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostMyTotpVerifyParams
+	// ------------- Required query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostMyTotpVerify(ctx, params)
+	return err
+}
+
+// UpdateMyPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMyPassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMyPasswordParams
+	// ------------- Required query parameter "current_password" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "current_password", ctx.QueryParams(), &params.CurrentPassword)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter current_password: %s", err))
+	}
+
+	// ------------- Required query parameter "new_password" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "new_password", ctx.QueryParams(), &params.NewPassword)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter new_password: %s", err))
+	}
+
+	// ------------- Optional query parameter "sign_out_other_sessions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sign_out_other_sessions", ctx.QueryParams(), &params.SignOutOtherSessions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sign_out_other_sessions: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMyPassword(ctx, params)
+	return err
+}
+
+// PostMyPhoneConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMyPhoneConfirm(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostMyPhoneConfirmParams
+	// ------------- Required query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostMyPhoneConfirm(ctx, params)
+	return err
+}
+
 // GetMyProfile converts echo context to params.
 func (w *ServerInterfaceWrapper) GetMyProfile(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetMyProfile(ctx)
 	return err
 }
 
+// PostPasswordForgot converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPasswordForgot(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPasswordForgotParams
+	// ------------- Required query parameter "phone_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "phone_number", ctx.QueryParams(), &params.PhoneNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter phone_number: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPasswordForgot(ctx, params)
+	return err
+}
+
+// PostPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPasswordResetParams
+	// ------------- Required query parameter "phone_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "phone_number", ctx.QueryParams(), &params.PhoneNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter phone_number: %s", err))
+	}
+
+	// ------------- Required query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// ------------- Required query parameter "new_password" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "new_password", ctx.QueryParams(), &params.NewPassword)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter new_password: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPasswordReset(ctx, params)
+	return err
+}
+
+// PostPhoneVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPhoneVerification(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPhoneVerificationParams
+	// ------------- Required query parameter "phone_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "phone_number", ctx.QueryParams(), &params.PhoneNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter phone_number: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPhoneVerification(ctx, params)
+	return err
+}
+
+// PostPhoneVerificationConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPhoneVerificationConfirm(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPhoneVerificationConfirmParams
+	// ------------- Required query parameter "phone_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "phone_number", ctx.QueryParams(), &params.PhoneNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter phone_number: %s", err))
+	}
+
+	// ------------- Required query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPhoneVerificationConfirm(ctx, params)
+	return err
+}
+
 // PostSignup converts echo context to params.
 func (w *ServerInterfaceWrapper) PostSignup(ctx echo.Context) error {
 	var err error
@@ -160,10 +898,30 @@ func (w *ServerInterfaceWrapper) PostSignup(ctx echo.Context) error {
 	return err
 }
 
+// PostTokenRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTokenRefresh(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTokenRefreshParams
+	// ------------- Required query parameter "refresh_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "refresh_token", ctx.QueryParams(), &params.RefreshToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTokenRefresh(ctx, params)
+	return err
+}
+
 // UpdateMyProfile converts echo context to params.
 func (w *ServerInterfaceWrapper) UpdateMyProfile(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params UpdateMyProfileParams
 	// ------------- Optional query parameter "phone_number" -------------
@@ -180,11 +938,167 @@ func (w *ServerInterfaceWrapper) UpdateMyProfile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter full_name: %s", err))
 	}
 
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", ctx.QueryParams(), &params.Language)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter language: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateMyProfile(ctx, params)
 	return err
 }
 
+// PostV2Login converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2Login(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2Login(ctx)
+	return err
+}
+
+// PostV2LoginMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2LoginMfa(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2LoginMfa(ctx)
+	return err
+}
+
+// GetV2Me converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV2Me(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetV2Me(ctx)
+	return err
+}
+
+// PatchV2Me converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchV2Me(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchV2Me(ctx)
+	return err
+}
+
+// PostV2MeTotp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2MeTotp(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2MeTotp(ctx)
+	return err
+}
+
+// PostV2MeTotpDisable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2MeTotpDisable(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2MeTotpDisable(ctx)
+	return err
+}
+
+// PostV2MeTotpVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2MeTotpVerify(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2MeTotpVerify(ctx)
+	return err
+}
+
+// PutV2MePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PutV2MePassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutV2MePassword(ctx)
+	return err
+}
+
+// PostV2MePhoneConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2MePhoneConfirm(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2MePhoneConfirm(ctx)
+	return err
+}
+
+// PostV2PasswordForgot converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2PasswordForgot(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2PasswordForgot(ctx)
+	return err
+}
+
+// PostV2PasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2PasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2PasswordReset(ctx)
+	return err
+}
+
+// PostV2PhoneVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2PhoneVerification(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2PhoneVerification(ctx)
+	return err
+}
+
+// PostV2PhoneVerificationConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2PhoneVerificationConfirm(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2PhoneVerificationConfirm(ctx)
+	return err
+}
+
+// PostV2Signup converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2Signup(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2Signup(ctx)
+	return err
+}
+
+// PostV2TokenRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV2TokenRefresh(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostV2TokenRefresh(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -213,35 +1127,157 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetJwks)
+	router.DELETE(baseURL+"/admin/users/:id/lock", wrapper.DeleteUserLock)
+	router.GET(baseURL+"/admin/users/:id/lock", wrapper.GetUserLock)
 	router.GET(baseURL+"/hello", wrapper.Hello)
 	router.POST(baseURL+"/login", wrapper.PostLogin)
+	router.POST(baseURL+"/login/mfa", wrapper.PostLoginMfa)
+	router.POST(baseURL+"/logout", wrapper.PostLogout)
+	router.POST(baseURL+"/logout-all", wrapper.PostLogoutAll)
+	router.DELETE(baseURL+"/my-mfa/totp", wrapper.DeleteMyTotp)
+	router.POST(baseURL+"/my-mfa/totp", wrapper.PostMyTotp)
+	router.POST(baseURL+"/my-mfa/totp/verify", wrapper.PostMyTotpVerify)
+	router.PUT(baseURL+"/my-password", wrapper.UpdateMyPassword)
+	router.POST(baseURL+"/my-phone/confirm", wrapper.PostMyPhoneConfirm)
 	router.GET(baseURL+"/my-profile", wrapper.GetMyProfile)
+	router.POST(baseURL+"/password/forgot", wrapper.PostPasswordForgot)
+	router.POST(baseURL+"/password/reset", wrapper.PostPasswordReset)
+	router.POST(baseURL+"/phone-verification", wrapper.PostPhoneVerification)
+	router.POST(baseURL+"/phone-verification/confirm", wrapper.PostPhoneVerificationConfirm)
 	router.POST(baseURL+"/signup", wrapper.PostSignup)
+	router.POST(baseURL+"/token/refresh", wrapper.PostTokenRefresh)
 	router.PATCH(baseURL+"/update-my-profile", wrapper.UpdateMyProfile)
+	router.POST(baseURL+"/v2/login", wrapper.PostV2Login)
+	router.POST(baseURL+"/v2/login/mfa", wrapper.PostV2LoginMfa)
+	router.GET(baseURL+"/v2/me", wrapper.GetV2Me)
+	router.PATCH(baseURL+"/v2/me", wrapper.PatchV2Me)
+	router.POST(baseURL+"/v2/me/mfa/totp", wrapper.PostV2MeTotp)
+	router.POST(baseURL+"/v2/me/mfa/totp/disable", wrapper.PostV2MeTotpDisable)
+	router.POST(baseURL+"/v2/me/mfa/totp/verify", wrapper.PostV2MeTotpVerify)
+	router.PUT(baseURL+"/v2/me/password", wrapper.PutV2MePassword)
+	router.POST(baseURL+"/v2/me/phone/confirm", wrapper.PostV2MePhoneConfirm)
+	router.POST(baseURL+"/v2/password/forgot", wrapper.PostV2PasswordForgot)
+	router.POST(baseURL+"/v2/password/reset", wrapper.PostV2PasswordReset)
+	router.POST(baseURL+"/v2/phone-verification", wrapper.PostV2PhoneVerification)
+	router.POST(baseURL+"/v2/phone-verification/confirm", wrapper.PostV2PhoneVerificationConfirm)
+	router.POST(baseURL+"/v2/signup", wrapper.PostV2Signup)
+	router.POST(baseURL+"/v2/token/refresh", wrapper.PostV2TokenRefresh)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RWTW/jNhD9KwO2R63sbtIC62O/NimaNsgHetgEC5ocWcxSJEMO46pB/ntBUkplb1xs",
-	"0G2aIifL0nDmcfje49wyYTtnDRoKbHHLgmix4/nxB++tP8HgrAmYXjhvHXpSmD93GAJf5Q/UO2QLFsgr",
-	"s2J3dxXzeB2VR8kW7+4DL6sx0C6vUBC7q9i/mj5FKtPYlEMrgUMdw7sUdXR4lhCQIp3+ngf0cIr+Rglk",
-	"FbtBH5Q1bMG+quf1PEVah4Y7xRZsL7+qmOPUZrCzFrXOdVZI6SfthJOy5lCyBTvIX1O85x0S+sAW726Z",
-	"SumvI/qeVSMqJdl0e+QjVsOhPNSKyxRcWpiBvJ7P04+whtBkJNw5rUTGMrsKaUe3k3xfemzYgn0x+4sF",
-	"s4ECs/uzyY2UGIRXjkpTzjAQeKToTWrN/nz/s5XdpN0DtX+xBI2NRmYqhNh13PcJU6sCqABXMRBwoAQR",
-	"jXRWGQKysEKC3kYIxD2hrOFYIw8IEjUSAqXlY3ydc8+0XakM3dmQt7XVhekS4EKgowCutQbBxG6JHriR",
-	"4HgIa+slNAq1DDUcEogWxYcA1CJITnyZgKxbpBZ9filst1Qm9w/wdxUo1HDurIEQhcAQKlDjAZQsh9+D",
-	"bfJTDENdDj/9dgZrRS1wvbJeUdvByenrr7/JELgOFpQRHrt0AHntgNo2Y50mashdCCU9p5JfmQ3sNZyb",
-	"7RWwVloPGOHg7OwY9udz+JZLOMHriIECCCuxvjCs2hLMsQ30c+79J4kmd/x9wf4o+VQ78g0H9r+Q4ulW",
-	"24se50+nx8mJPhsvKOTJGu76V87bRmmc+PPf6TjTtog5C4gH4LBE7pM07Qc0I/l5pNZ69Ufe2YVpkUv0",
-	"mzJNp3KDXjXD/qtiM1NlZNnxDj+Sb/qTmX1hCrVr+DX5w1oF3JLVHvxo/VJJiWanpt4iHfXHQyeeFVf3",
-	"no4v923a4stbJDjqYWxPJk5QKxPdZ3f/HXZ3Wqr9J37XRK3f5+cXbJ7puCG6F2WfA99hl42ejj1JeohO",
-	"csJXm37qOIn2U7UxuOm2l274KIw2Ok4ou4Q1sx4ScYt53o9WxUR9aWgaB8fsKPPMVHZRpp2yqEw1ecga",
-	"nX1YXoGqsQbVTAqVYSxFmY/SjUE1HNg13qCvICgjEBLyDfyCG7BG97BEbc0qjafpa/L+KtXj5RpY8zyY",
-	"WRAtNytMT9wA1x657AsUZVabqSdz4XA7vIHvrGm0EvRQf6KZdijv6/GXy3luw/R+ebyN/SPben7WMmqr",
-	"MEROJgLdv3STSQjePB2Ckf5b7lZIu3nvpwD0NyNto9dswVoit5jNtBVct2kWuLu8+zMAAP//jav/GTkR",
-	"AAA=",
+	"H4sIAAAAAAAC/+x9e3MTSbLvV6noe/+4N7YtGcMw4ImNG8DADrNj8MVm2BMDoS11p6Qat6q0VdU2OhP+",
+	"7icyq6rfrQfGlg3+y1Y/6pn5y0dlZv8VJWq+UBKkNdHhX5EGs1DSAP14ztN38J8cjMVfiZIWJP3LF4tM",
+	"JNwKJYcLrcYZzP/2p1ES75lkBnOO//1vDZPoMPpfw7KLobtrhsfurejy8jKOUjCJFgtsLjqMTmfAtOuW",
+	"CcOksuycZyKNLuPohZKTTCQ7G0/i+zfsQtgZszNgSa41SMuM5RZwjL+p5AzSmxzhsyRRubTMwnyhNNci",
+	"W7KMRsH4xIJmVik253LJJlxkkLJMTYU0URzNgKegabPfgdXLvWf4PP6s93ACiZKpYbm0IqNpc99nLrEn",
+	"M4jiyjzscgHRYSSkhSloHPJlHL1R9pXK5Y2uzBtl2YQ6vYyj8NxN7oxcMmVnoBlorTSO4lSpIy6XnrHM",
+	"jVJyIANPzoZNtJozOxOGJZlAKlaaTZR2lxYzJYHJfD4GfSVaCdwz50s2xp9WC0g3IJn3kud2prT475tl",
+	"qCNhjJBTXA0hCXxYoiEFaQXPTEQjM/liobSF9AhSwU9p+LsBpbFKlwEpfz15+ybCx30b2MWLGZdTOObG",
+	"XChdRfSFVgvQVji090A2WvgHK7tirBZyivOWcLH6ASOmcqRyOyKyHxkwRihpHJlMeJ7Z6HDCMwNxeHes",
+	"VAZc0qhxSkLjZv/RHk+j909FC2r8JyTWyYcU+ieoUugYc7NbfKqrbVzaDzD+JyzbTfNsin9A5nNs493J",
+	"wQ+Pozh6SX8/xc0u4yjR553LB51Xz0T3Yp/ZZb3bZ9jpi84eZWcLuenu8XPn1eX65cMhuQG7xmNam9Xr",
+	"eQIdu3UGS/orLMzNOk4p24oui6641nzZHiC22zWe37ic5nwKbUQLd5iasNyA3pvwBOFhDsbwKZiYccO4",
+	"ZK9P3rLHD5/uPWBIRIhvYWdARnEk0s6NQX3hhJSH1ho4cT0icT3iFuW7Ne3xvVDSQJJbcQ51Cc+MkAkQ",
+	"BmfcWGbyJAFjJnnGlKQBNmE3jvDBUaNjGovSc/wvSrmFPSvmEHVMJiuUnyZ3h3sjkgubt4gLPqrRfykj",
+	"6jsbnox71q0YXefu47NHE74WPRpL77U/vIvkQapRbmcoKhJulWZ8sWD4R7Jc5gZSpiFR56CXBZG0pjyf",
+	"8JFVZyDXs1v5aNyPXDS33omthHPSAUZeB2jN/riiITiNWFjDSDH084uZcndUbpmwpFy4501YLi8WmIap",
+	"ULJjQRpTrg0pjlbKg6MJfzHjWQZy2sFe8HkhNJiRkB08LyaAJBlGmYRmGC03E5IZp+h0s1FtDxs002gK",
+	"12RItDqcT/iAvbYs4VIqi8oSkYyDF07M617qpBtjuc1NVSLgKIq1+7RuZf37cY2qKovUtcKlWmHAbit6",
+	"N9Anbj8B0tw20E1orF+ioNyZReid9Rt6rB+Bbvn0OudVGpON4bobLAXLRWaQd1OYCAkpGy/Zu1cv2I9P",
+	"9n+MmQFpSy9Cn7HA5mhdMOweXwcSHGRLsuCqGXxENt1EWJ1YPs6AzXkyExL2NPCULmjg2BUumzMDDbOK",
+	"jTWXyYwpeRiMoJG3OGLnkKHRelUhZnOeoUCHdIT2SMzy0kIa0SRGOIk4WC0jq9Qo43oKMQtGnmtw7oyv",
+	"2AHdyPcdfmo4V2fYn5Amn0xEguMdmURh22GcFWMtDp6KkRP+5W/4LIw1MVtoNREZjKSyI/IVxKTkVX9X",
+	"qWFk+RnImDXNk3KgxRUNiN445YkGMxs1JoQoW4iVxmWVNq7wDLdrOQKJW+Yv4hBrF0BqlWVzHBfeM5bj",
+	"+sfsHLSYePpqNN6+5RC/81bQpUbwOQFIux9Cyh5pSEDabBkzMzejFDKBpFtQi+YWRpmYCxpeZannYGcq",
+	"pdHzLFMXkDor3IKWPBsR6TuKh898vsggOoxa1NglHB0/tpni5edFxiW97cBCGKYSt7tJIf49T8bsYgaS",
+	"rlhhMwhmN0iVT2edQllIY7lMOtjxmNtZaD/Y8nbGrdfhB7U5DtGwzhddPQSOEmm7j9c/N3qIGc+Mcugj",
+	"3Ez+teeRee91ypyXZ8D+f64sIJjShDUgJ6PNw8NSrNFA6sP45fT0mLmbNVW5wLDqXB/t73dpVLTgHaA2",
+	"Uxotm/mc62Vo90zIFP8PY0WdShi6ZfgcCOoclta3mpeWW7HyfnFYyi2vO6Q7FsAuFx1jfP/udc/IYrpI",
+	"S7LQMBGfIXVCIdfy0D+zh40ekuFpQJ+LBA7rI1z97EbccS5URs90bN5LWiidZ86SnAjIUtMk3LFWZ4Bg",
+	"Ck6wIdXQtIRpSwsc/kZ2/e9hWGvNeroZiCQuVdl+xcTBflsbySp+gFVjK/wFqMby+ZU1NyHZy8GDx4+Y",
+	"s4vX6yjUabxeVXnnDU7UPU17vsEeJfCuu1x6yLtnCxoNdQ+FJOEpCsJedbAmLtebwPXHu3t1KNPuyntw",
+	"1ncSHuxq/oSQuXc6kzzLRv0EcreNn3JyayzxsEh9O9Ht4Yk33yHC41XbdPr29PhloR+1R6DsAhXRUa6F",
+	"cw4EePU3DodDq+xi+N6APvHY+rfHB0+ePPnx4cHTgyf/z0Ciwf791+cnH/7r4c/HL385/ufD438df8z3",
+	"9w8eC2Ny0H+vvNwpP6mJ9lY/5wYeHjCQuKspw6kw9+z6HfNtxrUJdi6Q48q+HdrGW1J1Vax1lbT4vSHg",
+	"hZxmsJebmr+E/h36Vzt1kZ7mnlWHZkkTcg4WNgauQa/wrzj9PYj4kkCe04trNyJ4VCrN1Nwr8QZQ9n6R",
+	"cgtedn0h5HyJgLslSNRaj1JBOPxrGxu4qoSS6iJJwSlN3eqES8OyWNjyUljNit2Hpq1BtbRxLVNy2riE",
+	"x7Gj8dKCqdzwFvAoXyxAJ9xAxz00jfrupWIqbMd1s5yPVVYzUBfA0UBPZlzzxIKuDiNR0nIhzWgB2ii0",
+	"voScqK4HxlxKSEcmH7u9ws0unhpr4MkM0qbV1l6uLp4jbbO9jUEpp9vOaqJtdDppnsGgs6+uHjaWMG4o",
+	"hcOvX9Y4IM+1sMsT5CVHjw5enuV2Vv56FY5Afv1wGsWrkIrkB7mQnKuYtUGQvV2Adkq8W5FMGMvINWKY",
+	"BptrycgQe7T/kL1SeizSFGTFoqWOUgXOxplqLtEghbnbOYIFOtJpwN3M2oU7o0b6IBVaJOBliEOh6Oj1",
+	"acWGi1AMslIOnoM2bs4PBvuDfXxSLUDyhYgOo4d0CdULO6OFHA4uIMv2zqS6kMM/L87MIByrT7tk5yla",
+	"9CDThRLSskU+zoSZgTMG6VfC8EzQedqtYmhol+thWisfO5cedjGGFKUbOfV+ePDjgDlTyS1kwrUWgILl",
+	"32ci/be3rJnkc+QQsgVh6TYKu8Sm7IA9V3bGeEJneVymuG9If26IXANtKqTMKPeqH6RvYQwTpYFxalor",
+	"69waCZfMWJFleKDg/DWBHVUgmddpdBj9A+yvF2eGJFElDuxgf39FbMN2MQ31U9+OyIZ/wpIZsM5l4wMG",
+	"upssxlgJlIgj7wlAnjp5+4Z9gDHDJl1vcTTk6VzIIVrIZviXSC+H6Bd0VJOBhXX04wEBt9Vtc+nCxYYd",
+	"u5G/IRMT66gMe3DeBey2CJ3C7U0y4NqQuKye3DrZCbprk36mcSIH4cnxde5VoQuuiDlz8V9AAVaP9h/c",
+	"ZAxMLTqIun94k90XCOr6frSzuLYvYxMvoKLDP+qi6Y+ICDn6dPmpykzvaZ+Z33bsdgOw3ZxZnHhy7NLF",
+	"CKbKPzJFoUVhddVQRGGCU76If+wBuZtgnjKso2P/8GYZMXrPN98u3/wDbGAaRrt+EnZ9wTWfg6WQyj/+",
+	"ioQkPdXOouBOdC6NUgO1OoeVIZOfUL7NIMtURRmqE/8vdLe78//koJfb9l7oyp92JIhOwQT4uJsEVZAK",
+	"Yacw7M/cWERMnFgBpVaxKVi2VDnzp3oDdpwBN8Cc4uJOrsLzA6fsEIaSaazMWrRGp83C1uN+CWyD9eRd",
+	"/y5CZQbJmcNrPBgZ40CqoJyo+Vj4YzV30jpg7xdKhhi0mIk66pcnVQXIc/brh9Og2pLooMBKpjSjyMqY",
+	"pbAAmZLFKb0sIM0Z38GrZ7AcMIyVrangvh8nkpDaTaGOC8m6zQunbXvl2ZCqftpydBlGduweHnamP/nz",
+	"EZwihX2RlVYYBxKVcficUHBuyrhtmHPkIuFMwgVbcKFp0enoTshEw9yd0s+KfVKTanSfD/+jiXLrVlTI",
+	"2m4NGEpBwy5mivkzZGYvFMY2UthaGcSGW4i0xyWrxlMxIY0FTqdZ3grBTZsIKczM670owXFmleim97I5",
+	"TnaBpkndQN1nz3nKQpC8O5VjFHDOgtOC9IRcg2kqAT71wa3fVKsLpIQFaKFSGqHr4uAhc5ka+EqxS2XQ",
+	"OrbCvHduUI6Ea6DDa+YPr7HhEDz/+tixC+gaD/3k7xuGxyOucXyZFtUP5inD4HxMDCi66tJdjpWxFM3X",
+	"hvDtPXImT2aMG/Yx+tvjA/bkwcHew0c/PN778cnTj9HW/rpqc/v1tsLpq7FKQ9p1ztUlfhoHDZsLorhb",
+	"nFVcQDcn1JSEtxPanlXype54v4xXP13lwejyU4dQOmkwGG0mr4QwFi4fAoa13O/k6v5NytUK9+9aqj86",
+	"eHifzNXMzHl08HS9mtNMdbq6iuSwr1RtUKRsqt5gN6goGca93CHZWEB/Q7w5getVoq4IYIR6EKXCs2kk",
+	"uJLF/VoouIkZOsrx3sVMJLOgI5BfUslsyZRMoK5FNZUoCm4Jwth4uTtgz9iFVnLqxkZbbdyRV83UtuqC",
+	"67QuTpEeVG5XyqKjCd/MoqiGFV8Zz70X/nYYKA0EXw/JOzD7TztoWOky/qiZa3uPe7cH91547GLEcOwD",
+	"KnRuOuwVSewCE1Vut7X30MhqHYAHc6EWm1tEB5LHDgNxTeUUghDRwVrV1MEYQl4co6BOGTOjmPTYWUU5",
+	"PuVCYmAe9o0Rldjcks34ORBt+shUtoRViIRLsCNnRMnmRL1TtOxwON+3k+8rO9oaHja/4xUG2ONZdrNM",
+	"4GJJa84Ad3hXYwTHAVaVqrdRbMJ1XPgrihtodlFytms5BTwpXU3zz7LslpG9mwHPMlakAd8zwnUzAnuF",
+	"i/4sy9hJsejIGvPl3nzCKYRt89NOr1n2uoXUZFLTkzlLrpoQ2XviebQ8xbFvpGneKuVwpfe6d2lTYcgs",
+	"34HxfdqrEu6adZ/e6DL07k2R9VHs0PVy9s+OFijuE3vbRLBNQSIPgfFO5ErMqC/xUfG0BxtSWGbV1Hnx",
+	"yWvIJfNhowyTGKxiAtVzy4S0Cu+2eHvAVq4bGbJ+4cig9TkXeC9RciL03HvDK4A1JL/7csBe8CxDOSms",
+	"1xV9wIu35BcZT7w/2EfH9kjMAkuuzyKsRxt3CU23F2Gf7hmsTig+4e3mmOzEcm0dm9Q2Lo46SHFb9dIT",
+	"ebHZGE7WLRp9SZ5AwShDq/03/D921RIGNnNnaQgCxjVoypDquguqehbiDrga97kGZmbqQlY8Uit57He3",
+	"XHdRalezZraiXpD3knunwFLyL7kecCX8oTl5vG4V7LxwQq8XeKoJQov8+uzZuObFDr1WsCNccrhQvcLm",
+	"uUGlAvN1Szc0RmE7N7NlPnuVWqOHUzGZgC7BjsrThPZMNRKg4r1uju2LPNnswwwk6ylThRRAoBOyQ/Fu",
+	"sF6ro8IHfWCCyu1P7aSXRnqkMN5XQLkmGGAQszEdrZqGl8BYvvQpky7IF1iCTlvnKUMOEjKHdrxAFwy7",
+	"bJGj5XF59rkJDLcLb13ZW1+rjvEV2uvZv5oDd229sV0ZfccFAbvgj10JiiY/CeM4LQ7nAjUmr4oUfACR",
+	"NdjvFHN5G+TM/ZlF+8zimuUX0TA7WrICZQrRNVMSht6qu3aHbHH8UK1I4bQhMg+HOaHhHg7M5c8xC59t",
+	"6ZElaq/EzTRV7RnUbjfFQRHJhTKOaw/1q9ItnJbs6wK5Rfq2vFu1KKQdg11TK46L0ySlmeaSHNZqwopy",
+	"cd+fOd4kcER4qnaD1iqXThmqxhLOgUuq23djWrKjKFdPijnoKeGmrOiwQW4CBR06sNkCaz7KcPpTxQY8",
+	"1q+iTuyCcatxjdgSsm8ryLWAlY8ywM4zVtRAErKDWL0i6UYZXHc+cPIBq1JRr0v9H4DI4xfsGtEhdNEF",
+	"Du5WdUHukyFusu+wAdeZFNGRDYGaQkEVcTQMCuZwovRUbRy9gLIbWbbmyzLgT56sKhmrIq+5DPoT1f06",
+	"FymkzHbhXiG3WSE9HAsar9pNMM58LmRuMT74VJFk0XAOPPPxW9U2feAwTIWxQMW1hGVcmgu8VZjMF3xJ",
+	"vvlcUvQ54UMuw1BCU32qRNC/Xrl1vI8Qvm3q0LuSPl39rUmb9saAVQIMs6pCrLcgAHc3cU+OlBuWRQEY",
+	"xO/b2hXNFY9DIbICOpxRgPneDWjqcIbFntev1wPWtkMqRrk/9krjZh5ESM2lPp0/a60nax22EAnfQ8u1",
+	"B6t+bTfazl1djld3Y/t1urDi3uDXjSzD3eChkyANOERa3auaH9uqUAhoHU6TdSqUc6vM/AFLoaUIS/GZ",
+	"JeL5ExUD0rvkJ0IbB7Y/1bMW/TPB1FQSYsTOuTLWxQosQHud6zpVrjDijRUv7PX36gbcA+RtA6LfW/Td",
+	"q4JJABTAEr5btcs35b0ttaXD4/A+3PlSV29rB1Z4cguPrbBdelG1FWG6/LA0A+MhvsOW8wiAihxdDqi/",
+	"MfP3unLvMeAOZfRs7NYOhHWn/Nq7ymJpO3EdljhV4RoKFfivd7nfpBA4DSAUFOhKN8eUeAOsLInsCqk5",
+	"C6pSLNC1wNv19H22egd8CdM4c2pYooqNoREG2Q+ztyor/SSUJ78Hva1Ar1pA927muF89acSV3MsX312e",
+	"eacHfkfgfBL2APG4Flm0KSyHYiaG8UZMU1nKpJUi5S7XHg9lFDsrpjSzoY9dwXn3aYL6G2Seoi+/iOkr",
+	"qq1QmJerIsJJc5zYQw/wtVytMhgXbUUXXFYN5ZJpz7lbNY62BzF9zrBb4o3O/evlge9M7jM9UIS53YpA",
+	"9yvbR47OaGaOY1rBJcQ13CazLQNemkfQvbmHVAyoTxUaKs1QtLgz50IdagUllstC7hE3C1P9zgNxEVVw",
+	"CgfixZdExAAGaEGXHdFz9LpsNRceag+es1BE2XUas48RyI8RU5p9jESKcp+GYSBU1Kx8kdEFIrgvMZba",
+	"XSXL0hdV9fPxOFIggSz5u5hYsD/bEZlkL7rg0jEkPDf0jRRBXxuiwlChUzw/eP3zgP2iLhBXYv8pRtyl",
+	"2l7hcAjO3MEPjltJ10TMRFHz8IL7rzK5cYfjoQLYhCEIrDVdqQ3hQeopCx/QRvW0Ge8UzIiwNFpMZ5bx",
+	"C748ZHydMits7Ho52D/AgnewsHUYLFfVTwGpD3k+5AFVfbGtTKBaKFmLkEOpXan8NlWZKHbkWK8vtWGY",
+	"RBFAW0RKfF/q7ZXU2ZUv939hdS0/hzM3DE0ybKEhgdR9wCcYMo7+9opWy7pvGXclxLwxw54RBbrj1opq",
+	"UpQrKPm9b8UCFNUmvFlZ/d05MLze6fA5rYQxuSjeg/2DXTple+Ixf/Lh+B7IkS7CDG6D5bDjsKVbYLjc",
+	"bLhiEGU3bzNtGu3kpEcr4On8YF1dzhPURor6TXFZNrnyYUPSDel77/QB+DJNpn5gV36IYXWkYJ+V8vtB",
+	"qDfoQfO5SpdfsUhy5cvEl5eXTZvm8r7+Xr3+3moqfc7TNiStfqUFJA9+2OSl4tueR5AKforyvUy/WP3y",
+	"b2W9+B0WkWP/5/zg/9b5cXUxuTpP4rNVvuyoDVfqs3VGXc1orpjatfFa5Qvn18Bu11Ai7Z7qb6qEWJ0j",
+	"5v1x9AUnlM6OnhDz3w+Obmd0+ZbUsf9o/UtvlH21iyBqv21xn7Op2K2Wh6qCX16lbqsUXT6CunFOtDKs",
+	"2+ddEIejK+jh66Nb54fRbhjitjC57rqVdf2IvC3Peetj9Qs1m+FqmH8nDI4mpg+rJcBW6znHb09O65VH",
+	"ehWXI7jV1XS2Jbwt6WgHVWl6t3Xoq4at396fX/728vRlbYOr+uw2eqvbfl+j6prgHYO2biGqb1W+7QYw",
+	"8yYh8OZKnvWT+7piTFVVtfHS1ai9KGv0zRH7V6x6dE/wV68D1KL9tfWAauZZmaYUiD1cMptQfE4Ef1wP",
+	"K/rq5E7ngWWG0e3T3fvrtXxTnombLhTSpu3NCobUKLz6ypdjeqMIx3ekwqyp0XGP4V+A4W/gol6pokbo",
+	"Gye8F3TeeKOK5jXfTMN986564FOLywhfalOaIj42y0Lu559W5vl18A8tqFvPrdjo4M7kdm/Fa3fHe9GX",
+	"zd3DFWuyuttMQS+sdWauJd6Q2nwttFvt4zZrOM003W+dJOsJtQ2K3CKxtqTK1ktfHa19LWGMbDawEpY7",
+	"8lK/P2S+Yubnt88BK3M917HEFtp6/8tXAO/+7Mtro/S7oMh3ZCV+64TcWSKuRr3rsgwLSnUPrj8fbUD2",
+	"A/bCJ5UUL5ZfNcZzNhe9WskaKQOyy5igeii/j/CecbORJl6k4V0H9bvGtyL9B1+9834GCNVYE7cJX2zE",
+	"frvniM1krzp7bJj0VXBJ7fkKszS+erUhkjfSoa6Dfn3rvqfbGQXVnSx1B3yNXzOlKtAlPgH6PCRb5DqL",
+	"DqOZtYvD4TBTCc9mCqf76fJ/BgDiSClPx64AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
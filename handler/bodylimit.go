@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// BodyLimitMiddleware answers requests whose body is larger than limit
+// bytes with 413 Request Entity Too Large. Bodies without a Content-Length
+// are read up to the limit before the handler runs, so that a large body
+// is refused the same way wherever it would have been read.
+func BodyLimitMiddleware(limit int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			if req.ContentLength > limit {
+				return newError(http.StatusRequestEntityTooLarge, codeRequestTooLarge)
+			}
+			if req.ContentLength < 0 && req.Body != nil && req.Body != http.NoBody {
+				body, err := io.ReadAll(io.LimitReader(req.Body, limit+1))
+				if err != nil {
+					return newError(http.StatusBadRequest, codeMalformedBody)
+				}
+				if int64(len(body)) > limit {
+					return newError(http.StatusRequestEntityTooLarge, codeRequestTooLarge)
+				}
+				req.Body = io.NopCloser(bytes.NewReader(body))
+				req.ContentLength = int64(len(body))
+			}
+			return next(ctx)
+		}
+	}
+}
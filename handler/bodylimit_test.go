@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BodyLimitMiddleware(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	e.HTTPErrorHandler = s.HTTPErrorHandler
+	e.Use(BodyLimitMiddleware(8))
+	e.POST("/", func(ctx echo.Context) error {
+		body, err := io.ReadAll(ctx.Request().Body)
+		if err != nil {
+			return err
+		}
+		return ctx.String(http.StatusOK, string(body))
+	})
+
+	tests := []struct {
+		name    string
+		body    string
+		chunked bool
+		code    int
+	}{
+		{name: "within limit", body: "12345678", code: http.StatusOK},
+		{name: "too large", body: "123456789", code: http.StatusRequestEntityTooLarge},
+		{name: "chunked within limit", body: "1234", chunked: true, code: http.StatusOK},
+		{name: "chunked too large", body: "123456789", chunked: true, code: http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+			if test.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.code, rec.Code)
+			if test.code == http.StatusOK {
+				assert.Equal(t, test.body, rec.Body.String())
+				return
+			}
+			assert.Contains(t, rec.Body.String(), `"code":"request_too_large"`)
+		})
+	}
+}
@@ -1,17 +1,19 @@
 package handler
 
 import (
-	"fmt"
+	"database/sql"
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
-	"unicode"
-
-	"golang.org/x/crypto/bcrypt"
+	"time"
 
 	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/i18n"
+	"github.com/SawitProRecruitment/UserService/metrics"
 	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/SawitProRecruitment/UserService/totp"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo/v4"
 )
@@ -22,9 +24,19 @@ type User struct {
 	Password    string
 }
 
+// Claims identify the user by primary key in the "sub" claim, so tokens stay
+// valid when the user changes their name or phone number.
 type Claims struct {
-	Name        string `json:"full_name"`
-	PhoneNumber string `json:"phone_number"`
+	// SessionID is the refresh token family the token was issued with.
+	SessionID string `json:"sid,omitempty"`
+	// Scope lists the space separated scopes granted to the token.
+	Scope string `json:"scope,omitempty"`
+	// TokenUse is set on tokens that are not access tokens, such as the
+	// challenge tokens of a login with two-factor authentication.
+	TokenUse string `json:"token_use,omitempty"`
+	// Locale is the language the user prefers for messages, if they chose
+	// one.
+	Locale string `json:"locale,omitempty"`
 	jwt.StandardClaims
 }
 
@@ -38,234 +50,797 @@ func (s *Server) Hello(ctx echo.Context, params generated.HelloParams) error {
 	}
 	uid, err := s.Repository.GetTestById(ctx.Request().Context(), req)
 	if err != nil {
-		resp.Message = "User not found"
-		return ctx.JSON(http.StatusBadRequest, resp)
+		return newError(http.StatusNotFound, codeUserNotFound)
 	}
-	resp.Message = fmt.Sprintf("Hello User %s", uid.Name)
+	resp.Message = message(ctx, msgHello, uid.Name)
 	return ctx.JSON(http.StatusOK, resp)
 }
 
+// GetJwks implements generated.ServerInterface.
+func (s *Server) GetJwks(ctx echo.Context) error {
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, s.Keys.JWKS())
+}
+
 // GetMyProfile implements generated.ServerInterface.
 func (s *Server) GetMyProfile(ctx echo.Context) error {
-	authHeader := ctx.Request().Header.Get("Authorization")
-	if authHeader == "" || len("Bearer ") < 1 {
-		return echo.NewHTTPError(http.StatusForbidden, "Authorization header not found")
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
 	}
-	tokenString := authHeader[len("Bearer "):]
 
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte("sawit-pro-digital"), nil
-	})
+	user, err := s.Repository.GetUserByID(ctx.Request().Context(), principal.UserID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusForbidden, "Token is not valid")
+		return newError(http.StatusNotFound, codeProfileNotFound)
 	}
 
-	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
-		return echo.NewHTTPError(http.StatusForbidden, "Token not found")
+	profile := generated.Profile{
+		Name:        user.Name,
+		PhoneNumber: user.PhoneNumber,
 	}
-
-	return ctx.JSON(http.StatusOK, map[string]string{
-		"name":         claims.Name,
-		"phone_number": claims.PhoneNumber,
-	})
+	if lang, ok := i18n.Parse(user.Language); ok {
+		profileLanguage := generated.Language(lang)
+		profile.Language = &profileLanguage
+	}
+	return ctx.JSON(http.StatusOK, profile)
 }
 
 // PatchUpdateMyProfile implements generated.ServerInterface.
 func (s *Server) UpdateMyProfile(ctx echo.Context, params generated.UpdateMyProfileParams) error {
-	authHeader := ctx.Request().Header.Get("Authorization")
-	if authHeader == "" {
-		return echo.NewHTTPError(http.StatusForbidden, "Authorization header tidak ditemukan")
-	}
-	tokenString := authHeader[len("Bearer "):]
-
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte("sawit-pro-digital"), nil
-	})
+	principal, err := s.principal(ctx)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusForbidden, "Token tidak valid")
-	}
-
-	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
-		return echo.NewHTTPError(http.StatusForbidden, "Token tidak valid")
+		return err
 	}
 
 	var newPhoneNumber string
 	var newFullName string
+	var newLanguage string
 	if params.PhoneNumber != nil {
 		newPhoneNumber = *params.PhoneNumber
 	}
 	if params.FullName != nil {
 		newFullName = *params.FullName
 	}
+	if params.Language != nil {
+		newLanguage = string(*params.Language)
+	}
 
-	if (newPhoneNumber == "" || !validatePhoneNumber(newPhoneNumber)) && (newFullName == "" || !validateFullName(newFullName)) {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request data")
+	if newPhoneNumber == "" && newFullName == "" && newLanguage == "" {
+		return newError(http.StatusBadRequest, codeInvalidRequest)
+	}
+	var violations []violation
+	if newPhoneNumber != "" {
+		var ok bool
+		if newPhoneNumber, ok = s.normalizePhoneNumber(newPhoneNumber); !ok {
+			violations = append(violations, invalidPhoneNumber)
+		}
+	}
+	if newFullName != "" && !s.fullNameLength().allows(newFullName) {
+		violations = append(violations, s.fullNameLength().violation())
+	}
+	lang := language(ctx)
+	if newLanguage != "" {
+		parsed, ok := i18n.Parse(newLanguage)
+		if !ok {
+			violations = append(violations, invalidLanguage)
+		}
+		// The response is already in the language the user switched to.
+		lang, newLanguage = parsed, string(parsed)
+	}
+	if len(violations) > 0 {
+		return validationError(violations)
 	}
 
 	if newPhoneNumber != "" {
-		output, _ := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
+		output, err := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
 			PhoneNumber: newPhoneNumber,
 		})
 
-		if output.Name != "" {
-			return echo.NewHTTPError(http.StatusConflict, "Phone Number already exists")
+		if err == nil && output.ID != principal.UserID {
+			return newError(http.StatusConflict, codePhoneNumberTaken)
 		}
+		if err == nil {
+			// The phone number is not changing.
+			newPhoneNumber = ""
+		}
+	}
 
-		err := s.Repository.UpdatePhoneNumber(ctx.Request().Context(), newPhoneNumber, claims.PhoneNumber, claims.Name)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusForbidden, "Update Phone number failed")
+	if newFullName != "" || newLanguage != "" {
+		err = s.Repository.UpdateUserByID(ctx.Request().Context(), principal.UserID, repository.UserInput{
+			FullName: newFullName,
+			Language: newLanguage,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return newError(http.StatusNotFound, codeProfileNotFound)
 		}
-	} else if newFullName != "" {
-		err := s.Repository.UpdateName(ctx.Request().Context(), newFullName, claims.Name, claims.PhoneNumber)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusForbidden, "Update name failed")
+			return internalError(msgUpdateProfileFailed)
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]string{
-		"message": "Successfully updated user data",
+	// A new phone number only replaces the current one once the user proves
+	// they own it at /my-phone/confirm.
+	if newPhoneNumber != "" {
+		if err := s.sendOTP(ctx, lang, principal.UserID, otpPurposeChangePhone, newPhoneNumber); err != nil {
+			return err
+		}
+		return ctx.JSON(http.StatusAccepted, generated.Response{
+			Message: messages.Text(lang, msgPhoneChangeCodeSent),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: messages.Text(lang, msgProfileUpdated),
+	})
+}
+
+// PostMyPhoneConfirm implements generated.ServerInterface.
+func (s *Server) PostMyPhoneConfirm(ctx echo.Context, params generated.PostMyPhoneConfirmParams) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s.checkOTP(ctx, principal.UserID, otpPurposeChangePhone, params.Code)
+	if err != nil {
+		return err
+	}
+
+	output, err := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
+		PhoneNumber: stored.PhoneNumber,
+	})
+	if err == nil && output.ID != principal.UserID {
+		return newError(http.StatusConflict, codePhoneNumberTaken)
+	}
+	err = s.Repository.VerifyPhone(ctx.Request().Context(), principal.UserID, stored.PhoneNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return newError(http.StatusNotFound, codeProfileNotFound)
+	}
+	if err != nil {
+		return internalError(msgUpdateProfileFailed)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgPhoneChanged),
+	})
+}
+
+// UpdateMyPassword implements generated.ServerInterface.
+func (s *Server) UpdateMyPassword(ctx echo.Context, params generated.UpdateMyPasswordParams) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.Repository.GetUserByID(ctx.Request().Context(), principal.UserID)
+	if err != nil {
+		return newError(http.StatusNotFound, codeProfileNotFound)
+	}
+	if err := accountLocked(ctx, user); err != nil {
+		return err
+	}
+	if !checkPassword(user.Password, params.CurrentPassword) {
+		if err := s.recordFailedLogin(ctx.Request().Context(), user.ID); err != nil {
+			log.Println("failed to record failed login:", err)
+		}
+		return newError(http.StatusBadRequest, codeCurrentPasswordInvalid)
+	}
+	// Only the holder of the current password learns the policy's verdict.
+	if violations := s.passwordViolations("new_password", params.NewPassword, user.Name, user.PhoneNumber); len(violations) > 0 {
+		return validationError(violations)
+	}
+
+	reused, err := s.passwordUsedRecently(ctx.Request().Context(), user.ID, params.NewPassword)
+	if err != nil {
+		return internalError(msgChangePasswordFailed)
+	}
+	if reused {
+		return newError(http.StatusBadRequest, codePasswordReused)
+	}
+
+	hashedPassword, err := s.hashPassword(params.NewPassword)
+	if err != nil {
+		return internalError(msgHashPasswordFailed)
+	}
+	err = s.Repository.UpdatePassword(ctx.Request().Context(), user.ID, hashedPassword)
+	if err != nil {
+		return internalError(msgChangePasswordFailed)
+	}
+
+	if params.SignOutOtherSessions == nil || !*params.SignOutOtherSessions {
+		return ctx.JSON(http.StatusOK, generated.Response{
+			Message: message(ctx, msgPasswordChanged),
+		})
+	}
+
+	// Access tokens cannot be told apart by session, so every one of them is
+	// revoked. The refresh token of the caller's session is kept, which lets
+	// the caller carry on through /token/refresh.
+	err = s.Revocations.RevokeUserTokens(ctx.Request().Context(), user.ID, time.Now())
+	if err == nil && principal.SessionID != "" {
+		err = s.Repository.RevokeOtherRefreshTokens(ctx.Request().Context(), user.ID, principal.SessionID)
+	} else if err == nil {
+		err = s.Repository.RevokeUserRefreshTokens(ctx.Request().Context(), user.ID)
+	}
+	if err != nil {
+		return internalError(msgSignOutFailed)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgPasswordChangedSignedOut),
+	})
+}
+
+// PostPasswordForgot implements generated.ServerInterface.
+func (s *Server) PostPasswordForgot(ctx echo.Context, params generated.PostPasswordForgotParams) error {
+	if err := s.forgotPassword(ctx, params.PhoneNumber); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgResetCodeSent),
+	})
+}
+
+// forgotPassword texts a reset code to the phone number if it is the
+// verified number of an account. Callers answer the same either way.
+func (s *Server) forgotPassword(ctx echo.Context, phoneNumber string) error {
+	phoneNumber, ok := s.normalizePhoneNumber(phoneNumber)
+	if !ok {
+		return newError(http.StatusBadRequest, codeInvalidRequest)
+	}
+
+	output, err := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
+		PhoneNumber: phoneNumber,
+	})
+	// Only verified phone numbers can be trusted to reach the owner.
+	if err == nil && output.PhoneVerified {
+		if err := s.sendOTP(ctx, userLanguage(ctx, output), output.ID, otpPurposeResetPassword, phoneNumber); err != nil {
+			log.Println("failed to send password reset code:", err)
+		}
+	}
+	return nil
+}
+
+// PostPasswordReset implements generated.ServerInterface.
+func (s *Server) PostPasswordReset(ctx echo.Context, params generated.PostPasswordResetParams) error {
+	phoneNumber, ok := s.normalizePhoneNumber(params.PhoneNumber)
+	if !ok {
+		return newError(http.StatusBadRequest, codeVerificationCodeInvalid)
+	}
+	output, err := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
+		PhoneNumber: phoneNumber,
+	})
+	if err != nil || !output.PhoneVerified {
+		return newError(http.StatusBadRequest, codeVerificationCodeInvalid)
+	}
+	// The code is checked before the password, so that only the owner of
+	// the phone number learns that it belongs to an account.
+	if _, err := s.checkOTP(ctx, output.ID, otpPurposeResetPassword, params.Code); err != nil {
+		return err
+	}
+	// The code is used up by now, but a new one can be requested after
+	// choosing a different password.
+	if violations := s.passwordViolations("new_password", params.NewPassword, output.Name, phoneNumber); len(violations) > 0 {
+		return validationError(violations)
+	}
+	reused, err := s.passwordUsedRecently(ctx.Request().Context(), output.ID, params.NewPassword)
+	if err != nil {
+		return internalError(msgResetPasswordFailed)
+	}
+	if reused {
+		return newError(http.StatusBadRequest, codePasswordReused)
+	}
+
+	hashedPassword, err := s.hashPassword(params.NewPassword)
+	if err != nil {
+		return internalError(msgHashPasswordFailed)
+	}
+	err = s.Repository.UpdatePassword(ctx.Request().Context(), output.ID, hashedPassword)
+	if err != nil {
+		return internalError(msgResetPasswordFailed)
+	}
+
+	// Whoever knew the old password must not stay signed in. Tokens issued
+	// within the current second are denied as well.
+	if err := s.revokeUserSessions(ctx.Request().Context(), output.ID, time.Now()); err != nil {
+		return internalError(msgSignOutFailed)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgPasswordReset),
+	})
+}
+
+// PostPhoneVerification implements generated.ServerInterface.
+func (s *Server) PostPhoneVerification(ctx echo.Context, params generated.PostPhoneVerificationParams) error {
+	if err := s.requestPhoneVerification(ctx, params.PhoneNumber); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgVerificationCodeSent),
+	})
+}
+
+// requestPhoneVerification texts a verification code to the phone number if
+// it belongs to an account that has not verified it yet.
+func (s *Server) requestPhoneVerification(ctx echo.Context, phoneNumber string) error {
+	phoneNumber, ok := s.normalizePhoneNumber(phoneNumber)
+	if !ok {
+		return newError(http.StatusBadRequest, codeInvalidRequest)
+	}
+
+	output, err := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
+		PhoneNumber: phoneNumber,
+	})
+	if err == nil && !output.PhoneVerified {
+		if err := s.sendOTP(ctx, userLanguage(ctx, output), output.ID, otpPurposeVerifyPhone, phoneNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostPhoneVerificationConfirm implements generated.ServerInterface.
+func (s *Server) PostPhoneVerificationConfirm(ctx echo.Context, params generated.PostPhoneVerificationConfirmParams) error {
+	phoneNumber, ok := s.normalizePhoneNumber(params.PhoneNumber)
+	if !ok {
+		return newError(http.StatusBadRequest, codeVerificationCodeInvalid)
+	}
+	output, err := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
+		PhoneNumber: phoneNumber,
+	})
+	if err != nil {
+		return newError(http.StatusBadRequest, codeVerificationCodeInvalid)
+	}
+	if output.PhoneVerified {
+		return ctx.JSON(http.StatusOK, generated.Response{
+			Message: message(ctx, msgPhoneAlreadyVerified),
+		})
+	}
+
+	if _, err := s.checkOTP(ctx, output.ID, otpPurposeVerifyPhone, params.Code); err != nil {
+		return err
+	}
+	err = s.Repository.VerifyPhone(ctx.Request().Context(), output.ID, phoneNumber)
+	if err != nil {
+		return internalError(msgVerifyPhoneFailed)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgPhoneVerified),
 	})
 }
 
 // PostLogin implements generated.ServerInterface.
 func (s *Server) PostLogin(ctx echo.Context, params generated.PostLoginParams) error {
+	err := s.login(ctx, params.PhoneNumber, params.Password)
+	if errors.Is(err, errInvalidCredentials) {
+		return newError(http.StatusBadRequest, codeInvalidCredentials)
+	}
+	return err
+}
 
-	if !validatePhoneNumber(params.PhoneNumber) || !validatePassword(params.Password) {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request data")
+// login answers the tokens of the user, or a challenge for the second
+// factor. It returns errInvalidCredentials, which each API version answers
+// with its own status code, when the phone number or password is wrong.
+func (s *Server) login(ctx echo.Context, phoneNumber, password string) error {
+	// Passwords are not held to the current policy here, as they may have
+	// been chosen under an older one.
+	phoneNumber, ok := s.normalizePhoneNumber(phoneNumber)
+	if !ok || password == "" || len(password) > maxPasswordLength {
+		return newError(http.StatusBadRequest, codeInvalidRequest)
 	}
 	output, _ := s.Repository.GetUserData(ctx.Request().Context(), repository.UserInput{
-		PhoneNumber: params.PhoneNumber,
+		PhoneNumber: phoneNumber,
 	})
 
-	if err := bcrypt.CompareHashAndPassword([]byte(output.Password), []byte(params.Password)); err != nil {
-		log.Println(err)
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid phone number or password")
+	if err := accountLocked(ctx, output); err != nil {
+		metrics.Logins.WithLabelValues(metrics.LoginLocked).Inc()
+		return err
+	}
+
+	if !checkPassword(output.Password, password) {
+		if output.ID != 0 {
+			if err := s.recordFailedLogin(ctx.Request().Context(), output.ID); err != nil {
+				log.Println("failed to record failed login:", err)
+			}
+		}
+		metrics.Logins.WithLabelValues(metrics.LoginInvalidCredentials).Inc()
+		return errInvalidCredentials
+	}
+	s.rehashPassword(ctx.Request().Context(), output, password)
+
+	if output.TOTPEnabled {
+		challenge, err := s.issueMFAChallenge(output)
+		if err != nil {
+			return internalError(msgGenerateTokenFailed)
+		}
+		metrics.Logins.WithLabelValues(metrics.LoginMFARequired).Inc()
+		return ctx.JSON(http.StatusOK, challenge)
 	}
+	return s.completeLogin(ctx, output)
+}
 
-	// Create token
-	claims := &Claims{
-		PhoneNumber:    params.PhoneNumber,
-		Name:           output.Name,
-		StandardClaims: jwt.StandardClaims{},
+// PostLoginMfa implements generated.ServerInterface.
+func (s *Server) PostLoginMfa(ctx echo.Context, params generated.PostLoginMfaParams) error {
+	claims, userID, err := s.parseMFAChallenge(ctx.Request().Context(), params.MfaToken)
+	if errors.Is(err, errInvalidChallenge) {
+		return newError(http.StatusUnauthorized, codeMFAChallengeInvalid)
+	}
+	if err != nil {
+		return internalError(msgVerifyTokenFailed)
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	tokenString, err := token.SignedString([]byte("sawit-pro-digital"))
+	user, err := s.Repository.GetUserByID(ctx.Request().Context(), userID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Failed to generate token")
+		return newError(http.StatusUnauthorized, codeMFAChallengeInvalid)
+	}
+	if err := accountLocked(ctx, user); err != nil {
+		metrics.Logins.WithLabelValues(metrics.LoginLocked).Inc()
+		return err
+	}
+	state, err := s.Repository.GetTOTP(ctx.Request().Context(), userID)
+	if err != nil {
+		return internalError(msgVerifyCodeFailed)
+	}
+	if state.EnabledAt == nil {
+		return newError(http.StatusUnauthorized, codeMFAChallengeInvalid)
 	}
 
-	err = s.Repository.Logged(ctx.Request().Context(), params.PhoneNumber)
+	ok, err := s.verifySecondFactor(ctx.Request().Context(), userID, state.Secret, params.Code)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Failed login to account")
+		return internalError(msgVerifyCodeFailed)
 	}
-	return ctx.JSON(http.StatusOK, map[string]string{
-		"token": tokenString,
+	if !ok {
+		if err := s.recordFailedLogin(ctx.Request().Context(), userID); err != nil {
+			log.Println("failed to record failed login:", err)
+		}
+		metrics.Logins.WithLabelValues(metrics.LoginInvalidMFACode).Inc()
+		return newError(http.StatusUnauthorized, codeMFACodeInvalid)
+	}
+
+	// The challenge completes a single login.
+	err = s.Revocations.RevokeToken(ctx.Request().Context(), claims.Id, time.Unix(claims.ExpiresAt, 0))
+	if err != nil {
+		return internalError(msgVerifyTokenFailed)
+	}
+	return s.completeLogin(ctx, user)
+}
+
+// completeLogin issues the tokens of a user who passed every login step.
+func (s *Server) completeLogin(ctx echo.Context, user repository.QueryOutput) error {
+	resp, refreshToken, err := s.issueTokens(user, "")
+	if err != nil {
+		return internalError(msgGenerateTokenFailed)
+	}
+	err = s.Repository.CreateRefreshToken(ctx.Request().Context(), refreshToken)
+	if err != nil {
+		return internalError(msgGenerateTokenFailed)
+	}
+
+	err = s.Repository.Logged(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return internalError(msgLoginFailed)
+	}
+	metrics.Logins.WithLabelValues(metrics.LoginSucceeded).Inc()
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// PostMyTotp implements generated.ServerInterface.
+func (s *Server) PostMyTotp(ctx echo.Context) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+	user, err := s.Repository.GetUserByID(ctx.Request().Context(), principal.UserID)
+	if err != nil {
+		return newError(http.StatusNotFound, codeProfileNotFound)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return internalError(msgGenerateSecretFailed)
+	}
+	err = s.Repository.SetTOTPSecret(ctx.Request().Context(), principal.UserID, secret)
+	if errors.Is(err, sql.ErrNoRows) {
+		return newError(http.StatusConflict, codeMFAAlreadyEnabled)
+	}
+	if err != nil {
+		return internalError(msgGenerateSecretFailed)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TOTPEnrollment{
+		Secret:     secret,
+		OtpauthUri: totp.URI(s.TOTPIssuer, user.PhoneNumber, secret),
 	})
 }
 
-// PostSignup implements generated.ServerInterface.
-func (s *Server) PostSignup(ctx echo.Context, params generated.PostSignupParams) error {
-	isValid, errValMsg := validateInput(params.PhoneNumber, params.FullName, params.Password)
-	if !isValid {
-		return echo.NewHTTPError(http.StatusBadRequest, errValMsg)
+// PostMyTotpVerify implements generated.ServerInterface.
+func (s *Server) PostMyTotpVerify(ctx echo.Context, params generated.PostMyTotpVerifyParams) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+	state, err := s.Repository.GetTOTP(ctx.Request().Context(), principal.UserID)
+	if err != nil {
+		return newError(http.StatusNotFound, codeProfileNotFound)
+	}
+	if state.EnabledAt != nil {
+		return newError(http.StatusConflict, codeMFAAlreadyEnabled)
+	}
+	if state.Secret == "" {
+		return newError(http.StatusConflict, codeMFAEnrollmentNotStarted)
+	}
+
+	counter, ok := totp.Validate(state.Secret, strings.TrimSpace(params.Code), time.Now())
+	if !ok {
+		return newError(http.StatusBadRequest, codeMFACodeInvalid)
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return internalError(msgEnableMFAFailed)
+	}
+	err = s.Repository.EnableTOTP(ctx.Request().Context(), principal.UserID, counter, hashes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return newError(http.StatusConflict, codeMFAAlreadyEnabled)
+	}
+	if err != nil {
+		return internalError(msgEnableMFAFailed)
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
+	return ctx.JSON(http.StatusOK, generated.RecoveryCodes{
+		RecoveryCodes: codes,
+	})
+}
+
+// DeleteMyTotp implements generated.ServerInterface.
+func (s *Server) DeleteMyTotp(ctx echo.Context, params generated.DeleteMyTotpParams) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+	state, err := s.Repository.GetTOTP(ctx.Request().Context(), principal.UserID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to hash password ")
+		return newError(http.StatusNotFound, codeProfileNotFound)
+	}
+	if state.EnabledAt == nil {
+		return newError(http.StatusConflict, codeMFANotEnabled)
 	}
 
-	user := repository.UserInput{
-		PhoneNumber: params.PhoneNumber,
-		Password:    hashedPassword,
-		FullName:    params.FullName,
+	ok, err := s.verifySecondFactor(ctx.Request().Context(), principal.UserID, state.Secret, params.Code)
+	if err != nil {
+		return internalError(msgVerifyCodeFailed)
 	}
-	output, err := s.Repository.SignUp(ctx.Request().Context(), user)
+	if !ok {
+		return newError(http.StatusBadRequest, codeMFACodeInvalid)
+	}
+	err = s.Repository.DisableTOTP(ctx.Request().Context(), principal.UserID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Account already exists")
+		return internalError(msgDisableMFAFailed)
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]string{
-		"message": "Successfully signed up",
-		"ID":      strconv.Itoa(output.ID),
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgMFADisabled),
 	})
 }
 
-func validateInput(phoneNumber, fullName, password string) (bool, string) {
-	var errorMsgs []string
+// PostTokenRefresh implements generated.ServerInterface.
+func (s *Server) PostTokenRefresh(ctx echo.Context, params generated.PostTokenRefreshParams) error {
+	stored, err := s.Repository.GetRefreshToken(ctx.Request().Context(), hashRefreshToken(params.RefreshToken))
+	if err != nil || stored.RevokedAt != nil || !time.Now().Before(stored.ExpiresAt) {
+		return newError(http.StatusUnauthorized, codeRefreshTokenInvalid)
+	}
+	if stored.RotatedAt != nil {
+		return s.revokeReusedRefreshToken(ctx, stored)
+	}
+
+	user, err := s.Repository.GetUserByID(ctx.Request().Context(), stored.UserID)
+	if err != nil {
+		return newError(http.StatusUnauthorized, codeRefreshTokenInvalid)
+	}
 
-	if !validatePhoneNumber(phoneNumber) {
-		errorMsgs = append(errorMsgs, "Phone number must be at least 10 characters, start with +62")
+	resp, refreshToken, err := s.issueTokens(user, stored.FamilyID)
+	if err != nil {
+		return internalError(msgGenerateTokenFailed)
+	}
+	rotated, err := s.Repository.RotateRefreshToken(ctx.Request().Context(), stored.ID, refreshToken)
+	if err != nil {
+		return internalError(msgGenerateTokenFailed)
+	}
+	if !rotated {
+		// Another request exchanged the same token first.
+		return s.revokeReusedRefreshToken(ctx, stored)
 	}
 
-	if !validateFullName(fullName) {
-		errorMsgs = append(errorMsgs, "Full name must be between 3 and 60 characters")
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// PostLogout implements generated.ServerInterface.
+func (s *Server) PostLogout(ctx echo.Context) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.Revocations.RevokeToken(ctx.Request().Context(), principal.TokenID, principal.ExpiresAt)
+	if err != nil {
+		return internalError(msgLogoutFailed)
+	}
+	if principal.SessionID != "" {
+		err = s.Repository.RevokeRefreshTokenFamily(ctx.Request().Context(), principal.SessionID)
+		if err != nil {
+			return internalError(msgLogoutFailed)
+		}
 	}
 
-	if !validatePassword(password) {
-		errorMsgs = append(errorMsgs, "Password must be between 6 and 64 characters, contain at least 1 uppercase letter, 1 number, and 1 special character")
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgLoggedOut),
+	})
+}
+
+// PostLogoutAll implements generated.ServerInterface.
+func (s *Server) PostLogoutAll(ctx echo.Context) error {
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
 	}
 
-	if len(errorMsgs) > 0 {
-		errorMsg := strings.Join(errorMsgs, ", ")
-		return false, errorMsg
+	// Token timestamps have second precision, so the caller's own token is
+	// revoked explicitly in case it was issued within the current second.
+	err = s.revokeUserSessions(ctx.Request().Context(), principal.UserID, time.Now().Truncate(time.Second))
+	if err == nil {
+		err = s.Revocations.RevokeToken(ctx.Request().Context(), principal.TokenID, principal.ExpiresAt)
+	}
+	if err != nil {
+		return internalError(msgLogoutFailed)
 	}
 
-	return true, ""
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgLoggedOutAll),
+	})
 }
 
-func validatePhoneNumber(phoneNumber string) bool {
-	if !strings.HasPrefix(phoneNumber, "+62") {
-		log.Println("++")
-		return false
+// GetUserLock implements generated.ServerInterface.
+func (s *Server) GetUserLock(ctx echo.Context, id int) error {
+	state, err := s.Repository.GetLoginState(ctx.Request().Context(), id)
+	if err != nil {
+		return newError(http.StatusNotFound, codeUserNotFound)
 	}
 
-	numberWithoutPrefix := strings.TrimPrefix(phoneNumber, "+62")
+	resp := generated.LockState{
+		UserId:              state.UserID,
+		FailedLoginAttempts: state.FailedLoginAttempts,
+		LastFailedLoginAt:   state.LastFailedLoginAt,
+		Locked:              state.LockedUntil != nil && time.Now().Before(*state.LockedUntil),
+	}
+	if resp.Locked {
+		resp.LockedUntil = state.LockedUntil
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
 
-	for _, char := range numberWithoutPrefix {
-		if char < '0' || char > '9' {
-			log.Println("angg")
-			return false
-		}
+// DeleteUserLock implements generated.ServerInterface.
+func (s *Server) DeleteUserLock(ctx echo.Context, id int) error {
+	err := s.Repository.UnlockUser(ctx.Request().Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return newError(http.StatusNotFound, codeUserNotFound)
+	}
+	if err != nil {
+		return internalError(msgUnlockUserFailed)
+	}
+	return ctx.JSON(http.StatusOK, generated.Response{
+		Message: message(ctx, msgUserUnlocked),
+	})
+}
+
+// revokeReusedRefreshToken revokes the whole family of a refresh token that
+// was presented after it had already been exchanged.
+func (s *Server) revokeReusedRefreshToken(ctx echo.Context, stored repository.RefreshTokenOutput) error {
+	log.Printf("refresh token reuse detected for user %d, revoking family %s", stored.UserID, stored.FamilyID)
+	if err := s.Repository.RevokeRefreshTokenFamily(ctx.Request().Context(), stored.FamilyID); err != nil {
+		return internalError(msgRevokeRefreshTokenFailed)
 	}
+	return newError(http.StatusUnauthorized, codeRefreshTokenInvalid)
+}
 
-	if len(numberWithoutPrefix) < 9 || len(numberWithoutPrefix) > 12 {
-		log.Println("preff")
-		return false
+// PostSignup implements generated.ServerInterface.
+func (s *Server) PostSignup(ctx echo.Context, params generated.PostSignupParams) error {
+	id, err := s.signUp(ctx, params.PhoneNumber, params.FullName, params.Password)
+	if errors.Is(err, errAccountExists) {
+		return newError(http.StatusBadRequest, codeAccountExists)
+	}
+	if err != nil {
+		return err
 	}
 
-	return true
+	return ctx.JSON(http.StatusOK, map[string]string{
+		"message": message(ctx, msgSignedUp),
+		"ID":      strconv.Itoa(id),
+	})
 }
 
-func validateFullName(fullName string) bool {
-	if len(fullName) < 3 || len(fullName) > 60 {
-		return false
+// signUp creates an account and returns its ID. It returns errAccountExists,
+// which each API version answers with its own status code, when the phone
+// number is taken.
+func (s *Server) signUp(ctx echo.Context, phoneNumber, fullName, password string) (int, error) {
+	phoneNumber, violations := s.validateInput(phoneNumber, fullName, password)
+	if len(violations) > 0 {
+		metrics.Signups.WithLabelValues(metrics.SignupInvalid).Inc()
+		return 0, validationError(violations)
+	}
+
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return 0, internalError(msgHashPasswordFailed)
+	}
+
+	user := repository.UserInput{
+		PhoneNumber: phoneNumber,
+		Password:    hashedPassword,
+		FullName:    fullName,
+	}
+	output, err := s.Repository.SignUp(ctx.Request().Context(), user)
+	if err != nil {
+		metrics.Signups.WithLabelValues(metrics.SignupExists).Inc()
+		return 0, errAccountExists
 	}
+	metrics.Signups.WithLabelValues(metrics.SignupSucceeded).Inc()
 
-	return true
+	// The account is created either way; the code can be sent again through
+	// /phone-verification.
+	if err := s.sendOTP(ctx, language(ctx), output.ID, otpPurposeVerifyPhone, phoneNumber); err != nil {
+		log.Println("failed to send phone verification code:", err)
+	}
+	return output.ID, nil
 }
 
-func validatePassword(password string) bool {
-	if len(password) < 6 || len(password) > 64 {
-		return false
+// Violations of the fields of an account, shared by signup and profile
+// updates.
+var (
+	invalidPhoneNumber = violation{Field: "phone_number", Code: codeInvalidPhoneNumber}
+	invalidLanguage    = violation{Field: "language", Code: codeInvalidLanguage}
+)
+
+// validateInput checks the fields of a new account and returns every rule
+// they break, along with the phone number in E.164 format.
+func (s *Server) validateInput(phoneNumber, fullName, password string) (string, []violation) {
+	var violations []violation
+
+	phoneNumber, ok := s.normalizePhoneNumber(phoneNumber)
+	if !ok {
+		violations = append(violations, invalidPhoneNumber)
 	}
 
-	hasUppercase := false
-	hasNumber := false
-	hasSpecial := false
-	for _, char := range password {
-		switch {
-		case 'A' <= char && char <= 'Z':
-			hasUppercase = true
-		case '0' <= char && char <= '9':
-			hasNumber = true
-		case unicode.IsPunct(char) || unicode.IsSymbol(char):
-			hasSpecial = true
-		}
+	if !s.fullNameLength().allows(fullName) {
+		violations = append(violations, s.fullNameLength().violation())
 	}
 
-	return hasUppercase && hasNumber && hasSpecial
+	return phoneNumber, append(violations, s.passwordViolations("password", password, fullName, phoneNumber)...)
+}
+
+// FullNameLength bounds the length of full names, in bytes.
+type FullNameLength struct {
+	Min int
+	Max int
+}
+
+// DefaultFullNameLength keeps the bounds full names always had. Max is also
+// the size of the full_name column.
+var DefaultFullNameLength = FullNameLength{Min: 3, Max: 60}
+
+func (l FullNameLength) allows(fullName string) bool {
+	return len(fullName) >= l.Min && len(fullName) <= l.Max
+}
+
+func (l FullNameLength) violation() violation {
+	return violation{Field: "full_name", Code: codeInvalidFullName, Args: []interface{}{l.Min, l.Max}}
+}
+
+// fullNameLength returns the bounds of full names, which servers built
+// without NewServer leave unset.
+func (s *Server) fullNameLength() FullNameLength {
+	if s.FullNameLength == (FullNameLength{}) {
+		return DefaultFullNameLength
+	}
+	return s.FullNameLength
 }
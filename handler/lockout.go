@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/metrics"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// LockoutPolicy decides how long an account is locked after consecutive
+// failed logins. Once Threshold failures are reached the account is locked
+// for BaseDuration, and every further failure doubles the lock up to
+// MaxDuration. Failures are forgotten after ResetAfter without a new one.
+type LockoutPolicy struct {
+	Threshold    int
+	BaseDuration time.Duration
+	MaxDuration  time.Duration
+	ResetAfter   time.Duration
+}
+
+var DefaultLockoutPolicy = LockoutPolicy{
+	Threshold:    5,
+	BaseDuration: time.Minute,
+	MaxDuration:  time.Hour,
+	ResetAfter:   24 * time.Hour,
+}
+
+// LockDuration returns how long to lock an account after the given number of
+// consecutive failures, or zero when it should stay unlocked.
+func (p LockoutPolicy) LockDuration(failures int) time.Duration {
+	if p.Threshold <= 0 || failures < p.Threshold {
+		return 0
+	}
+	d := p.BaseDuration
+	for i := p.Threshold; i < failures && d < p.MaxDuration; i++ {
+		d *= 2
+	}
+	if d > p.MaxDuration {
+		d = p.MaxDuration
+	}
+	return d
+}
+
+// recordFailedLogin counts a failed password for the user and locks the
+// account when the policy says so.
+func (s *Server) recordFailedLogin(ctx context.Context, userID int) error {
+	now := time.Now()
+	attempts, err := s.Repository.RecordFailedLogin(ctx, userID, now.Add(-s.Lockout.ResetAfter))
+	if err != nil {
+		return err
+	}
+	if d := s.Lockout.LockDuration(attempts); d > 0 {
+		log.Printf("locking user %d for %s after %d failed logins", userID, d, attempts)
+		if err := s.Repository.LockUser(ctx, userID, now.Add(d)); err != nil {
+			return err
+		}
+		metrics.Lockouts.Inc()
+	}
+	return nil
+}
+
+// accountLocked answers 423 Locked with a Retry-After header while the user
+// is locked out, and returns nil otherwise.
+func accountLocked(ctx echo.Context, user repository.QueryOutput) error {
+	if user.LockedUntil == nil || !time.Now().Before(*user.LockedUntil) {
+		return nil
+	}
+	retryAfter := int(time.Until(*user.LockedUntil)/time.Second) + 1
+	ctx.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	return newError(http.StatusLocked, codeAccountLocked)
+}
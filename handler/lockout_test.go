@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LockoutPolicy_LockDuration(t *testing.T) {
+	policy := LockoutPolicy{
+		Threshold:    3,
+		BaseDuration: time.Minute,
+		MaxDuration:  10 * time.Minute,
+	}
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{failures: 0, want: 0},
+		{failures: 2, want: 0},
+		{failures: 3, want: time.Minute},
+		{failures: 4, want: 2 * time.Minute},
+		{failures: 5, want: 4 * time.Minute},
+		{failures: 6, want: 8 * time.Minute},
+		{failures: 7, want: 10 * time.Minute},
+		{failures: 100, want: 10 * time.Minute},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, policy.LockDuration(test.failures), "failures = %d", test.failures)
+	}
+}
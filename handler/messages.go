@@ -0,0 +1,430 @@
+package handler
+
+import (
+	"github.com/SawitProRecruitment/UserService/i18n"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// Keys of the messages of successful responses.
+const (
+	msgHello                    = "hello"
+	msgSignedUp                 = "signed_up"
+	msgProfileUpdated           = "profile_updated"
+	msgPhoneChangeCodeSent      = "phone_change_code_sent"
+	msgPhoneChanged             = "phone_changed"
+	msgPasswordChanged          = "password_changed"
+	msgPasswordChangedSignedOut = "password_changed_signed_out"
+	msgResetCodeSent            = "reset_code_sent"
+	msgPasswordReset            = "password_reset"
+	msgVerificationCodeSent     = "verification_code_sent"
+	msgPhoneAlreadyVerified     = "phone_already_verified"
+	msgPhoneVerified            = "phone_verified"
+	msgMFADisabled              = "mfa_disabled"
+	msgLoggedOut                = "logged_out"
+	msgLoggedOutAll             = "logged_out_all"
+	msgUserUnlocked             = "user_unlocked"
+)
+
+// Keys of the text messages sent to users.
+const (
+	msgVerificationCodeSMS = "verification_code_sms"
+)
+
+// Keys of the details of internal errors.
+const (
+	msgUpdateProfileFailed      = "update_profile_failed"
+	msgChangePasswordFailed     = "change_password_failed"
+	msgHashPasswordFailed       = "hash_password_failed"
+	msgSignOutFailed            = "sign_out_failed"
+	msgResetPasswordFailed      = "reset_password_failed"
+	msgVerifyPhoneFailed        = "verify_phone_failed"
+	msgSendCodeFailed           = "send_code_failed"
+	msgVerifyCodeFailed         = "verify_code_failed"
+	msgGenerateTokenFailed      = "generate_token_failed"
+	msgVerifyTokenFailed        = "verify_token_failed"
+	msgRevokeRefreshTokenFailed = "revoke_refresh_token_failed"
+	msgLoginFailed              = "login_failed"
+	msgLogoutFailed             = "logout_failed"
+	msgGenerateSecretFailed     = "generate_secret_failed"
+	msgEnableMFAFailed          = "enable_mfa_failed"
+	msgDisableMFAFailed         = "disable_mfa_failed"
+	msgUnlockUserFailed         = "unlock_user_failed"
+	msgResponseInvalid          = "response_invalid"
+)
+
+// messages holds every user-facing text of the handlers, keyed by problem
+// code, violation code or message key. Test_Messages fails when one of them
+// lacks a translation.
+var messages = i18n.Catalog{
+	// Problem titles.
+	codeInvalidRequest: {
+		i18n.English:    "Invalid request data",
+		i18n.Indonesian: "Data permintaan tidak valid",
+	},
+	codeValidationFailed: {
+		i18n.English:    "Request data is not valid",
+		i18n.Indonesian: "Data permintaan tidak memenuhi ketentuan",
+	},
+	codeMalformedBody: {
+		i18n.English:    "Request body is not valid JSON",
+		i18n.Indonesian: "Isi permintaan bukan JSON yang valid",
+	},
+	codeUnsupportedMediaType: {
+		i18n.English:    "Request body must be JSON",
+		i18n.Indonesian: "Isi permintaan harus berupa JSON",
+	},
+	codeRequestTooLarge: {
+		i18n.English:    "Request is too large",
+		i18n.Indonesian: "Permintaan terlalu besar",
+	},
+	codeAuthorizationMissing: {
+		i18n.English:    "Authorization header not found",
+		i18n.Indonesian: "Header Authorization tidak ditemukan",
+	},
+	codeTokenInvalid: {
+		i18n.English:    "Token is not valid",
+		i18n.Indonesian: "Token tidak valid",
+	},
+	codeTokenRevoked: {
+		i18n.English:    "Token has been revoked",
+		i18n.Indonesian: "Token sudah dicabut",
+	},
+	codeInsufficientScope: {
+		i18n.English:    "Token does not grant access to this resource",
+		i18n.Indonesian: "Token tidak memberi akses ke sumber daya ini",
+	},
+	codeInvalidCredentials: {
+		i18n.English:    "Invalid phone number or password",
+		i18n.Indonesian: "Nomor telepon atau kata sandi salah",
+	},
+	codeAccountLocked: {
+		i18n.English:    "Account is temporarily locked",
+		i18n.Indonesian: "Akun dikunci sementara",
+	},
+	codeAccountExists: {
+		i18n.English:    "Account already exists",
+		i18n.Indonesian: "Akun sudah terdaftar",
+	},
+	codeProfileNotFound: {
+		i18n.English:    "Profile not found",
+		i18n.Indonesian: "Profil tidak ditemukan",
+	},
+	codeUserNotFound: {
+		i18n.English:    "User not found",
+		i18n.Indonesian: "Pengguna tidak ditemukan",
+	},
+	codePhoneNumberTaken: {
+		i18n.English:    "Phone number already exists",
+		i18n.Indonesian: "Nomor telepon sudah digunakan",
+	},
+	codeCurrentPasswordInvalid: {
+		i18n.English:    "Current password is not valid",
+		i18n.Indonesian: "Kata sandi saat ini salah",
+	},
+	codePasswordReused: {
+		i18n.English:    "Password was used recently, choose a different one",
+		i18n.Indonesian: "Kata sandi ini baru saja dipakai, pilih kata sandi lain",
+	},
+	codeRefreshTokenInvalid: {
+		i18n.English:    "Refresh token is not valid",
+		i18n.Indonesian: "Refresh token tidak valid",
+	},
+	codeMFAChallengeInvalid: {
+		i18n.English:    "Challenge token is not valid",
+		i18n.Indonesian: "Token tantangan tidak valid",
+	},
+	codeMFACodeInvalid: {
+		i18n.English:    "Invalid verification code",
+		i18n.Indonesian: "Kode verifikasi salah",
+	},
+	codeMFAAlreadyEnabled: {
+		i18n.English:    "Two-factor authentication is already enabled",
+		i18n.Indonesian: "Autentikasi dua faktor sudah aktif",
+	},
+	codeMFANotEnabled: {
+		i18n.English:    "Two-factor authentication is not enabled",
+		i18n.Indonesian: "Autentikasi dua faktor belum aktif",
+	},
+	codeMFAEnrollmentNotStarted: {
+		i18n.English:    "Two-factor authentication enrollment was not started",
+		i18n.Indonesian: "Pendaftaran autentikasi dua faktor belum dimulai",
+	},
+	codeVerificationCodeInvalid: {
+		i18n.English:    "Verification code is not valid",
+		i18n.Indonesian: "Kode verifikasi tidak valid",
+	},
+	codeVerificationCodeExpired: {
+		i18n.English:    "Verification code has expired",
+		i18n.Indonesian: "Kode verifikasi sudah kedaluwarsa",
+	},
+	codeVerificationCodeAttemptsExceeded: {
+		i18n.English:    "Too many attempts, request a new verification code",
+		i18n.Indonesian: "Terlalu banyak percobaan, minta kode verifikasi baru",
+	},
+	codeVerificationCodeSentRecently: {
+		i18n.English:    "Verification code was sent recently",
+		i18n.Indonesian: "Kode verifikasi baru saja dikirim",
+	},
+	codeSMSDeliveryFailed: {
+		i18n.English:    "Failed to send verification code",
+		i18n.Indonesian: "Gagal mengirim kode verifikasi",
+	},
+	codeRateLimited: {
+		i18n.English:    "Too many requests",
+		i18n.Indonesian: "Terlalu banyak permintaan",
+	},
+	codeNotFound: {
+		i18n.English:    "Not found",
+		i18n.Indonesian: "Tidak ditemukan",
+	},
+	codeMethodNotAllowed: {
+		i18n.English:    "Method not allowed",
+		i18n.Indonesian: "Metode tidak diizinkan",
+	},
+	codeInternalError: {
+		i18n.English:    "Internal server error",
+		i18n.Indonesian: "Terjadi kesalahan pada server",
+	},
+
+	// Violations.
+	codeInvalidPhoneNumber: {
+		i18n.English:    "Phone number is not valid, numbers outside Indonesia need their country code",
+		i18n.Indonesian: "Nomor telepon tidak valid, nomor di luar Indonesia harus diawali kode negara",
+	},
+	codeInvalidFullName: {
+		i18n.English:    "Full name must be between %d and %d characters",
+		i18n.Indonesian: "Nama lengkap harus terdiri dari %d sampai %d karakter",
+	},
+	codeInvalidLanguage: {
+		i18n.English:    "Language must be en or id",
+		i18n.Indonesian: "Bahasa harus en atau id",
+	},
+	codeMissingValue: {
+		i18n.English:    "Value is required",
+		i18n.Indonesian: "Nilai wajib diisi",
+	},
+	codeInvalidValue: {
+		i18n.English:    "Value does not match the API specification",
+		i18n.Indonesian: "Nilai tidak sesuai dengan spesifikasi API",
+	},
+	codePasswordBreached: {
+		i18n.English:    "Password has appeared in a data breach, choose a different one",
+		i18n.Indonesian: "Kata sandi ini pernah bocor dalam kebocoran data, pilih kata sandi lain",
+	},
+	passwordpolicy.CodeTooShort: {
+		i18n.English:    "Password must be at least %d characters",
+		i18n.Indonesian: "Kata sandi minimal %d karakter",
+	},
+	passwordpolicy.CodeTooLong: {
+		i18n.English:    "Password must be at most %d characters",
+		i18n.Indonesian: "Kata sandi maksimal %d karakter",
+	},
+	passwordpolicy.CodeTooManyBytes: {
+		i18n.English:    "Password must be at most %d bytes",
+		i18n.Indonesian: "Kata sandi maksimal %d byte",
+	},
+	passwordpolicy.CodeMissingUppercase: {
+		i18n.English:    "Password must contain an uppercase letter",
+		i18n.Indonesian: "Kata sandi harus mengandung huruf kapital",
+	},
+	passwordpolicy.CodeMissingLowercase: {
+		i18n.English:    "Password must contain a lowercase letter",
+		i18n.Indonesian: "Kata sandi harus mengandung huruf kecil",
+	},
+	passwordpolicy.CodeMissingDigit: {
+		i18n.English:    "Password must contain a number",
+		i18n.Indonesian: "Kata sandi harus mengandung angka",
+	},
+	passwordpolicy.CodeMissingSymbol: {
+		i18n.English:    "Password must contain a special character",
+		i18n.Indonesian: "Kata sandi harus mengandung karakter khusus",
+	},
+	passwordpolicy.CodeRepeatedCharacters: {
+		i18n.English:    "Password must not repeat a character more than %d times in a row",
+		i18n.Indonesian: "Kata sandi tidak boleh mengulang karakter yang sama lebih dari %d kali berturut-turut",
+	},
+	passwordpolicy.CodePersonalInfo: {
+		i18n.English:    "Password must not contain your name or phone number",
+		i18n.Indonesian: "Kata sandi tidak boleh mengandung nama atau nomor telepon Anda",
+	},
+	passwordpolicy.CodeBannedSubstring: {
+		i18n.English:    "Password must not contain common words",
+		i18n.Indonesian: "Kata sandi tidak boleh mengandung kata yang umum",
+	},
+
+	// Successful responses.
+	msgHello: {
+		i18n.English:    "Hello User %s",
+		i18n.Indonesian: "Halo Pengguna %s",
+	},
+	msgSignedUp: {
+		i18n.English:    "Successfully signed up",
+		i18n.Indonesian: "Berhasil mendaftar",
+	},
+	msgProfileUpdated: {
+		i18n.English:    "Successfully updated user data",
+		i18n.Indonesian: "Data pengguna berhasil diperbarui",
+	},
+	msgPhoneChangeCodeSent: {
+		i18n.English:    "Verification code sent to the new phone number",
+		i18n.Indonesian: "Kode verifikasi dikirim ke nomor telepon baru",
+	},
+	msgPhoneChanged: {
+		i18n.English:    "Successfully changed phone number",
+		i18n.Indonesian: "Nomor telepon berhasil diubah",
+	},
+	msgPasswordChanged: {
+		i18n.English:    "Successfully changed password",
+		i18n.Indonesian: "Kata sandi berhasil diubah",
+	},
+	msgPasswordChangedSignedOut: {
+		i18n.English:    "Successfully changed password and signed out other sessions",
+		i18n.Indonesian: "Kata sandi berhasil diubah dan sesi lain telah dikeluarkan",
+	},
+	msgResetCodeSent: {
+		i18n.English:    "Reset code sent if the phone number belongs to an account",
+		i18n.Indonesian: "Kode reset dikirim jika nomor telepon terdaftar pada sebuah akun",
+	},
+	msgPasswordReset: {
+		i18n.English:    "Successfully reset password",
+		i18n.Indonesian: "Kata sandi berhasil diatur ulang",
+	},
+	msgVerificationCodeSent: {
+		i18n.English:    "Verification code sent if the phone number needs one",
+		i18n.Indonesian: "Kode verifikasi dikirim jika nomor telepon perlu diverifikasi",
+	},
+	msgPhoneAlreadyVerified: {
+		i18n.English:    "Phone number is already verified",
+		i18n.Indonesian: "Nomor telepon sudah terverifikasi",
+	},
+	msgPhoneVerified: {
+		i18n.English:    "Successfully verified phone number",
+		i18n.Indonesian: "Nomor telepon berhasil diverifikasi",
+	},
+	msgMFADisabled: {
+		i18n.English:    "Successfully disabled two-factor authentication",
+		i18n.Indonesian: "Autentikasi dua faktor berhasil dinonaktifkan",
+	},
+	msgLoggedOut: {
+		i18n.English:    "Successfully logged out",
+		i18n.Indonesian: "Berhasil keluar",
+	},
+	msgLoggedOutAll: {
+		i18n.English:    "Successfully logged out from all sessions",
+		i18n.Indonesian: "Berhasil keluar dari semua sesi",
+	},
+	msgUserUnlocked: {
+		i18n.English:    "Successfully unlocked user",
+		i18n.Indonesian: "Kunci pengguna berhasil dibuka",
+	},
+
+	// Details of internal errors.
+	msgUpdateProfileFailed: {
+		i18n.English:    "Failed to update profile",
+		i18n.Indonesian: "Gagal memperbarui profil",
+	},
+	msgChangePasswordFailed: {
+		i18n.English:    "Failed to change password",
+		i18n.Indonesian: "Gagal mengubah kata sandi",
+	},
+	msgHashPasswordFailed: {
+		i18n.English:    "Failed to hash password",
+		i18n.Indonesian: "Gagal memproses kata sandi",
+	},
+	msgSignOutFailed: {
+		i18n.English:    "Failed to sign out sessions",
+		i18n.Indonesian: "Gagal mengeluarkan sesi",
+	},
+	msgResetPasswordFailed: {
+		i18n.English:    "Failed to reset password",
+		i18n.Indonesian: "Gagal mengatur ulang kata sandi",
+	},
+	msgVerifyPhoneFailed: {
+		i18n.English:    "Failed to verify phone number",
+		i18n.Indonesian: "Gagal memverifikasi nomor telepon",
+	},
+	msgSendCodeFailed: {
+		i18n.English:    "Failed to send verification code",
+		i18n.Indonesian: "Gagal mengirim kode verifikasi",
+	},
+	msgVerifyCodeFailed: {
+		i18n.English:    "Failed to verify code",
+		i18n.Indonesian: "Gagal memverifikasi kode",
+	},
+	msgGenerateTokenFailed: {
+		i18n.English:    "Failed to generate token",
+		i18n.Indonesian: "Gagal membuat token",
+	},
+	msgVerifyTokenFailed: {
+		i18n.English:    "Failed to verify token",
+		i18n.Indonesian: "Gagal memverifikasi token",
+	},
+	msgRevokeRefreshTokenFailed: {
+		i18n.English:    "Failed to revoke refresh token",
+		i18n.Indonesian: "Gagal mencabut refresh token",
+	},
+	msgLoginFailed: {
+		i18n.English:    "Failed to log in",
+		i18n.Indonesian: "Gagal masuk",
+	},
+	msgLogoutFailed: {
+		i18n.English:    "Failed to log out",
+		i18n.Indonesian: "Gagal keluar",
+	},
+	msgGenerateSecretFailed: {
+		i18n.English:    "Failed to generate secret",
+		i18n.Indonesian: "Gagal membuat kunci rahasia",
+	},
+	msgEnableMFAFailed: {
+		i18n.English:    "Failed to enable two-factor authentication",
+		i18n.Indonesian: "Gagal mengaktifkan autentikasi dua faktor",
+	},
+	msgDisableMFAFailed: {
+		i18n.English:    "Failed to disable two-factor authentication",
+		i18n.Indonesian: "Gagal menonaktifkan autentikasi dua faktor",
+	},
+	msgUnlockUserFailed: {
+		i18n.English:    "Failed to unlock user",
+		i18n.Indonesian: "Gagal membuka kunci pengguna",
+	},
+	msgResponseInvalid: {
+		i18n.English:    "Response does not match the API specification",
+		i18n.Indonesian: "Respons tidak sesuai dengan spesifikasi API",
+	},
+
+	// Text messages.
+	msgVerificationCodeSMS: {
+		i18n.English:    "Your verification code is %s. It expires in %d minutes.",
+		i18n.Indonesian: "Kode verifikasi Anda adalah %s. Kode berlaku selama %d menit.",
+	},
+}
+
+// language returns the language of the messages answered to a request: the
+// preference of the signed in user, then the Accept-Language header, then
+// i18n.Default.
+func language(ctx echo.Context) i18n.Language {
+	if principal, ok := PrincipalFromContext(ctx.Request().Context()); ok && principal.Language != "" {
+		return principal.Language
+	}
+	if lang, ok := i18n.Negotiate(ctx.Request().Header.Get("Accept-Language")); ok {
+		return lang
+	}
+	return i18n.Default
+}
+
+// userLanguage returns the language of the messages sent to a user outside
+// of a response, such as text messages: the preference of the user, then
+// the language of the request.
+func userLanguage(ctx echo.Context, user repository.QueryOutput) i18n.Language {
+	if lang, ok := i18n.Parse(user.Language); ok {
+		return lang
+	}
+	return language(ctx)
+}
+
+// message returns the message of key in the language of the request.
+func message(ctx echo.Context, key string, args ...interface{}) string {
+	return messages.Text(language(ctx), key, args...)
+}
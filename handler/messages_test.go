@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/i18n"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// messageKey matches the names of the constants whose values are looked up
+// in messages: problem and violation codes, and message keys.
+var messageKey = regexp.MustCompile(`^(code|msg|Code)[A-Z]`)
+
+// formatVerb matches the fmt verbs of a message.
+var formatVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*[a-zA-Z%]`)
+
+// messageKeys returns the values of the message key constants declared in
+// the non-test files of dir.
+func messageKeys(t *testing.T, dir string) map[string]string {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("ParseDir(%q) err = %v", dir, err)
+	}
+
+	keys := map[string]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					value := spec.(*ast.ValueSpec)
+					for i, name := range value.Names {
+						if !messageKey.MatchString(name.Name) || i >= len(value.Values) {
+							continue
+						}
+						lit, ok := value.Values[i].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							continue
+						}
+						key, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							t.Fatalf("Unquote(%s) err = %v", lit.Value, err)
+						}
+						keys[name.Name] = key
+					}
+				}
+			}
+		}
+	}
+	return keys
+}
+
+func Test_Messages(t *testing.T) {
+	assert.Empty(t, messages.Missing(), "messages without a translation")
+
+	for _, dir := range []string{".", "../passwordpolicy"} {
+		keys := messageKeys(t, dir)
+		assert.NotEmpty(t, keys, dir)
+		for name, key := range keys {
+			assert.Contains(t, messages, key, "%s of %s has no message", name, dir)
+		}
+	}
+
+	for key, translations := range messages {
+		want := formatVerb.FindAllString(translations[i18n.Default], -1)
+		for lang, text := range translations {
+			assert.Equal(t, want, formatVerb.FindAllString(text, -1), "verbs of %s in %s", key, lang)
+		}
+	}
+}
+
+func Test_Language(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		principal      *Principal
+		want           i18n.Language
+	}{
+		{name: "default", want: i18n.English},
+		{name: "header", acceptLanguage: "id-ID,id;q=0.9,en;q=0.8", want: i18n.Indonesian},
+		{name: "unsupported header", acceptLanguage: "fr", want: i18n.English},
+		{name: "user preference", acceptLanguage: "en", principal: &Principal{UserID: 1, Language: i18n.Indonesian}, want: i18n.Indonesian},
+		{name: "user without preference", acceptLanguage: "id", principal: &Principal{UserID: 1}, want: i18n.Indonesian},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if test.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", test.acceptLanguage)
+			}
+			if test.principal != nil {
+				req = req.WithContext(WithPrincipal(context.Background(), test.principal))
+			}
+			ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+			assert.Equal(t, test.want, language(ctx))
+		})
+	}
+}
+
+func Test_HTTPErrorHandler_Language(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	e.HTTPErrorHandler = s.HTTPErrorHandler
+	e.GET("/fail", func(ctx echo.Context) error {
+		return validationError([]violation{DefaultFullNameLength.violation()})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/fail", nil)
+	req.Header.Set("Accept-Language", "id")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "id", rec.Header().Get("Content-Language"))
+	assert.Equal(t, `{"code":"validation_failed","detail":"Nama lengkap harus terdiri dari 3 sampai 60 karakter","instance":"/fail","status":400,"title":"Data permintaan tidak memenuhi ketentuan","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"invalid_full_name","field":"full_name","message":"Nama lengkap harus terdiri dari 3 sampai 60 karakter"}]}`, strings.TrimSpace(rec.Body.String()))
+}
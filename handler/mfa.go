@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/SawitProRecruitment/UserService/totp"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+)
+
+const (
+	// tokenUseMFA marks the challenge tokens returned by /login for users
+	// with two-factor authentication. They are only accepted by /login/mfa.
+	tokenUseMFA     = "mfa"
+	mfaChallengeTTL = 5 * time.Minute
+
+	defaultTOTPIssuer = "UserService"
+	recoveryCodeCount = 10
+)
+
+var errInvalidChallenge = errors.New("invalid mfa challenge")
+
+// issueMFAChallenge signs a short-lived token proving that the user passed
+// the password step of the login.
+func (s *Server) issueMFAChallenge(user repository.QueryOutput) (generated.MfaChallenge, error) {
+	now := time.Now()
+	token, err := s.Keys.Sign(&Claims{
+		TokenUse: tokenUseMFA,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   strconv.Itoa(user.ID),
+			Id:        uuid.NewString(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(mfaChallengeTTL).Unix(),
+		},
+	})
+	if err != nil {
+		return generated.MfaChallenge{}, err
+	}
+	return generated.MfaChallenge{
+		Status:    generated.MfaRequired,
+		MfaToken:  token,
+		ExpiresIn: int(mfaChallengeTTL / time.Second),
+	}, nil
+}
+
+// parseMFAChallenge verifies a challenge token from issueMFAChallenge that
+// was not used yet.
+func (s *Server) parseMFAChallenge(ctx context.Context, tokenString string) (*Claims, int, error) {
+	claims := &Claims{}
+	token, err := s.Keys.Parse(tokenString, claims)
+	if err != nil || !token.Valid || claims.TokenUse != tokenUseMFA {
+		return nil, 0, errInvalidChallenge
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil || userID <= 0 {
+		return nil, 0, errInvalidChallenge
+	}
+	revoked, err := s.Revocations.IsTokenRevoked(ctx, repository.TokenRevocationInput{
+		TokenID:  claims.Id,
+		UserID:   userID,
+		IssuedAt: time.Unix(claims.IssuedAt, 0),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if revoked {
+		return nil, 0, errInvalidChallenge
+	}
+	return claims, userID, nil
+}
+
+// verifySecondFactor accepts either a current TOTP code or an unused recovery
+// code. Both can only be used once.
+func (s *Server) verifySecondFactor(ctx context.Context, userID int, secret, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if isTOTPCode(code) {
+		counter, ok := totp.Validate(secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+		return s.Repository.UseTOTPCounter(ctx, userID, counter)
+	}
+	return s.Repository.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRecoveryCodes returns fresh recovery codes such as "k3vq7-2mxfa" and
+// the hashes to store for them.
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(encoding.EncodeToString(b))[:10]
+		code = code[:5] + "-" + code[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case and separators, so codes can be typed the
+// way they are read.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
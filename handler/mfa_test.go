@@ -0,0 +1,317 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/SawitProRecruitment/UserService/totp"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func Test_PostLogin_MfaRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	mockRepo.EXPECT().GetUserData(gomock.Any(), gomock.Any()).Return(repository.QueryOutput{
+		ID:          1,
+		Password:    "$2a$10$aWgB75Bp8DtTygKxoKXUsuGA2cE/eycXqT3YvoproS9BAJiu5fpSS",
+		TOTPEnabled: true,
+	}, nil)
+
+	keySet := newTestKeySet(t)
+	s := Server{
+		Repository: mockRepo,
+		Keys:       keySet,
+		Lockout:    DefaultLockoutPolicy,
+	}
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+	err := s.PostLogin(c, generated.PostLoginParams{
+		PhoneNumber: "+62888732928",
+		Password:    "aaaaA1&",
+	})
+	assert.NoError(t, err)
+
+	var body generated.MfaChallenge
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, generated.MfaRequired, body.Status)
+	assert.Equal(t, 300, body.ExpiresIn)
+
+	claims := &Claims{}
+	_, err = keySet.Parse(body.MfaToken, claims)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", claims.Subject)
+	assert.Equal(t, tokenUseMFA, claims.TokenUse)
+}
+
+func Test_PostLoginMfa(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	keySet := newTestKeySet(t)
+	enabledAt := time.Now()
+	code, _ := totp.Code(testTOTPSecret, totp.Counter(time.Now()))
+
+	newChallenge := func() string {
+		s := Server{Keys: keySet}
+		challenge, err := s.issueMFAChallenge(repository.QueryOutput{ID: 1})
+		if err != nil {
+			t.Fatalf("issueMFAChallenge() err = %v", err)
+		}
+		return challenge.MfaToken
+	}
+	usedChallenge := newChallenge()
+	revocations := repository.NewMemoryRevocationStore()
+	claims := &Claims{}
+	_, _ = keySet.Parse(usedChallenge, claims)
+	_ = revocations.RevokeToken(context.Background(), claims.Id, time.Now().Add(time.Minute))
+
+	accessToken := &Claims{}
+	accessToken.Subject = "1"
+	accessToken.ExpiresAt = time.Now().Add(time.Minute).Unix()
+
+	tests := []struct {
+		name     string
+		params   generated.PostLoginMfaParams
+		mockFunc func()
+		err      string
+	}{
+		{
+			name:   "totp code",
+			params: generated.PostLoginMfaParams{MfaToken: newChallenge(), Code: code},
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+				mockRepo.EXPECT().UseTOTPCounter(gomock.Any(), 1, gomock.Any()).Return(true, nil)
+				mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().Logged(gomock.Any(), 1).Return(nil)
+			},
+		},
+		{
+			name:   "recovery code",
+			params: generated.PostLoginMfaParams{MfaToken: newChallenge(), Code: "K3VQ7-2MXFA"},
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+				mockRepo.EXPECT().UseRecoveryCode(gomock.Any(), 1, hashRecoveryCode("k3vq72mxfa")).Return(true, nil)
+				mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().Logged(gomock.Any(), 1).Return(nil)
+			},
+		},
+		{
+			name:   "replayed totp code",
+			params: generated.PostLoginMfaParams{MfaToken: newChallenge(), Code: code},
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+				mockRepo.EXPECT().UseTOTPCounter(gomock.Any(), 1, gomock.Any()).Return(false, nil)
+				mockRepo.EXPECT().RecordFailedLogin(gomock.Any(), 1, gomock.Any()).Return(1, nil)
+			},
+			err: "code=401, message=Invalid verification code",
+		},
+		{
+			name:   "wrong code",
+			params: generated.PostLoginMfaParams{MfaToken: newChallenge(), Code: "000000"},
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+				mockRepo.EXPECT().RecordFailedLogin(gomock.Any(), 1, gomock.Any()).Return(1, nil)
+			},
+			err: "code=401, message=Invalid verification code",
+		},
+		{
+			name:   "used challenge",
+			params: generated.PostLoginMfaParams{MfaToken: usedChallenge, Code: code},
+			err:    "code=401, message=Challenge token is not valid",
+		},
+		{
+			name:   "access token as challenge",
+			params: generated.PostLoginMfaParams{MfaToken: signTestToken(t, keySet, accessToken), Code: code},
+			err:    "code=401, message=Challenge token is not valid",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+
+			if test.mockFunc != nil {
+				test.mockFunc()
+			}
+			s := Server{
+				Repository:      mockRepo,
+				Revocations:     revocations,
+				Keys:            keySet,
+				AccessTokenTTL:  time.Minute,
+				RefreshTokenTTL: time.Hour,
+				Lockout:         DefaultLockoutPolicy,
+			}
+			err := s.PostLoginMfa(c, test.params)
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			var body generated.TokenResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.NotEmpty(t, body.Token)
+
+			// The challenge cannot complete a second login.
+			claims := &Claims{}
+			_, _ = keySet.Parse(test.params.MfaToken, claims)
+			revoked, _ := revocations.IsTokenRevoked(context.Background(), repository.TokenRevocationInput{TokenID: claims.Id})
+			assert.True(t, revoked)
+		})
+	}
+}
+
+func Test_PostMyTotp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	mockRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1, PhoneNumber: "+62888732928"}, nil).Times(2)
+	mockRepo.EXPECT().SetTOTPSecret(gomock.Any(), 1, gomock.Any()).Return(nil)
+	mockRepo.EXPECT().SetTOTPSecret(gomock.Any(), 1, gomock.Any()).Return(sql.ErrNoRows)
+
+	s := Server{
+		Repository: mockRepo,
+		TOTPIssuer: "UserService",
+	}
+	e := echo.New()
+	newContext := func(rec *httptest.ResponseRecorder) echo.Context {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: 1}))
+		return e.NewContext(req, rec)
+	}
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, s.PostMyTotp(newContext(rec)))
+	var body generated.TOTPEnrollment
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	u, err := url.Parse(body.OtpauthUri)
+	assert.NoError(t, err)
+	assert.Equal(t, "/UserService:+62888732928", u.Path)
+	assert.Equal(t, body.Secret, u.Query().Get("secret"))
+
+	err = s.PostMyTotp(newContext(httptest.NewRecorder()))
+	if assert.Error(t, err) {
+		assert.Equal(t, "code=409, message=Two-factor authentication is already enabled", err.Error())
+	}
+}
+
+func Test_PostMyTotpVerify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	enabledAt := time.Now()
+	code, _ := totp.Code(testTOTPSecret, totp.Counter(time.Now()))
+	tests := []struct {
+		name     string
+		code     string
+		mockFunc func()
+		err      string
+	}{
+		{
+			name: "success",
+			code: code,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret}, nil)
+				mockRepo.EXPECT().EnableTOTP(gomock.Any(), 1, gomock.Any(), gomock.Len(recoveryCodeCount)).Return(nil)
+			},
+		},
+		{
+			name: "wrong code",
+			code: "000000",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret}, nil)
+			},
+			err: "code=400, message=Invalid verification code",
+		},
+		{
+			name: "not started",
+			code: code,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{}, nil)
+			},
+			err: "code=409, message=Two-factor authentication enrollment was not started",
+		},
+		{
+			name: "already enabled",
+			code: code,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil)
+			},
+			err: "code=409, message=Two-factor authentication is already enabled",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: 1}))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			test.mockFunc()
+			s := Server{
+				Repository: mockRepo,
+			}
+			err := s.PostMyTotpVerify(c, generated.PostMyTotpVerifyParams{Code: test.code})
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			var body generated.RecoveryCodes
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Len(t, body.RecoveryCodes, recoveryCodeCount)
+			for _, code := range body.RecoveryCodes {
+				assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+			}
+		})
+	}
+}
+
+func Test_DeleteMyTotp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	enabledAt := time.Now()
+	mockRepo.EXPECT().GetTOTP(gomock.Any(), 1).Return(repository.TOTPOutput{Secret: testTOTPSecret, EnabledAt: &enabledAt}, nil).Times(2)
+	mockRepo.EXPECT().UseRecoveryCode(gomock.Any(), 1, hashRecoveryCode("k3vq7-2mxfa")).Return(true, nil)
+	mockRepo.EXPECT().UseRecoveryCode(gomock.Any(), 1, hashRecoveryCode("aaaaa-aaaaa")).Return(false, nil)
+	mockRepo.EXPECT().DisableTOTP(gomock.Any(), 1).Return(nil)
+
+	s := Server{
+		Repository: mockRepo,
+	}
+	e := echo.New()
+	newContext := func(rec *httptest.ResponseRecorder) echo.Context {
+		req := httptest.NewRequest(http.MethodDelete, "/", nil)
+		req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: 1}))
+		return e.NewContext(req, rec)
+	}
+
+	err := s.DeleteMyTotp(newContext(httptest.NewRecorder()), generated.DeleteMyTotpParams{Code: "aaaaa-aaaaa"})
+	if assert.Error(t, err) {
+		assert.Equal(t, "code=400, message=Invalid verification code", err.Error())
+	}
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, s.DeleteMyTotp(newContext(rec), generated.DeleteMyTotpParams{Code: "k3vq7-2mxfa"}))
+	assert.Equal(t, `{"message":"Successfully disabled two-factor authentication"}`, strings.TrimSpace(rec.Body.String()))
+}
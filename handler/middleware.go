@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/i18n"
+	"github.com/SawitProRecruitment/UserService/metrics"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+// bearerScheme is the name of the security scheme declared in api.yml.
+const bearerScheme = "bearerAuth"
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID    int
+	TokenID   string
+	SessionID string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	Scopes    []string
+	// Language is the language the user prefers for messages, or empty
+	// when the Accept-Language header decides.
+	Language i18n.Language
+}
+
+// HasScopes reports whether the principal was granted every given scope.
+func (p *Principal) HasScopes(scopes []string) bool {
+	for _, want := range scopes {
+		found := false
+		for _, have := range p.Scopes {
+			if have == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the principal stored by AuthMiddleware.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// WithPrincipal returns a copy of ctx carrying the principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// AuthMiddleware enforces the security requirements that api.yml declares
+// for each operation. Requests to secured operations must carry a valid,
+// unrevoked bearer token; the resulting Principal is stored in the request
+// context for the handlers.
+func (s *Server) AuthMiddleware(swagger *openapi3.T) echo.MiddlewareFunc {
+	requirements := make(map[string]openapi3.SecurityRequirements)
+	for path, item := range swagger.Paths {
+		route := pathParam.ReplaceAllString(path, ":$1")
+		for method, operation := range item.Operations() {
+			security := swagger.Security
+			if operation.Security != nil {
+				security = *operation.Security
+			}
+			requirements[method+" "+route] = security
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			security := requirements[ctx.Request().Method+" "+ctx.Path()]
+			if len(security) == 0 {
+				return next(ctx)
+			}
+
+			var scopeSets [][]string
+			for _, requirement := range security {
+				scopes, ok := requirement[bearerScheme]
+				if len(requirement) == 0 {
+					// An empty requirement makes authentication optional.
+					return next(ctx)
+				}
+				if ok {
+					scopeSets = append(scopeSets, scopes)
+				}
+			}
+
+			principal, err := s.authenticate(ctx)
+			if err != nil {
+				return err
+			}
+			allowed := false
+			for _, scopes := range scopeSets {
+				if principal.HasScopes(scopes) {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return forbidden(ctx)
+			}
+
+			ctx.SetRequest(ctx.Request().WithContext(WithPrincipal(ctx.Request().Context(), principal)))
+			return next(ctx)
+		}
+	}
+}
+
+// authenticate verifies the bearer token of the request and rejects tokens
+// that were revoked through /logout or /logout-all.
+func (s *Server) authenticate(ctx echo.Context) (*Principal, error) {
+	authHeader := ctx.Request().Header.Get("Authorization")
+	const prefix = "Bearer "
+	if len(authHeader) <= len(prefix) || !strings.EqualFold(authHeader[:len(prefix)], prefix) {
+		return nil, unauthorized(ctx, "", codeAuthorizationMissing)
+	}
+	tokenString := strings.TrimSpace(authHeader[len(prefix):])
+
+	claims := &Claims{}
+	token, err := s.Keys.Parse(tokenString, claims)
+	if err != nil || !token.Valid || claims.TokenUse != "" {
+		return nil, unauthorized(ctx, "invalid_token", codeTokenInvalid)
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil || userID <= 0 {
+		return nil, unauthorized(ctx, "invalid_token", codeTokenInvalid)
+	}
+
+	principal := &Principal{
+		UserID:    userID,
+		TokenID:   claims.Id,
+		SessionID: claims.SessionID,
+		IssuedAt:  time.Unix(claims.IssuedAt, 0),
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+		Scopes:    strings.Fields(claims.Scope),
+	}
+	if lang, ok := i18n.Parse(claims.Locale); ok {
+		principal.Language = lang
+	}
+
+	revoked, err := s.Revocations.IsTokenRevoked(ctx.Request().Context(), repository.TokenRevocationInput{
+		TokenID:  principal.TokenID,
+		UserID:   principal.UserID,
+		IssuedAt: principal.IssuedAt,
+	})
+	if err != nil {
+		return nil, internalError(msgVerifyTokenFailed)
+	}
+	if revoked {
+		return nil, unauthorized(ctx, "invalid_token", codeTokenRevoked)
+	}
+	return principal, nil
+}
+
+// principal returns the caller of a secured operation.
+func (s *Server) principal(ctx echo.Context) (*Principal, error) {
+	principal, ok := PrincipalFromContext(ctx.Request().Context())
+	if !ok {
+		return nil, unauthorized(ctx, "", codeAuthorizationMissing)
+	}
+	return principal, nil
+}
+
+// unauthorized answers with 401 and a RFC 6750 challenge. errorCode is left
+// empty when the request carried no credentials at all.
+func unauthorized(ctx echo.Context, errorCode, code string) error {
+	metrics.TokenValidationFailures.WithLabelValues(code).Inc()
+	problem := newError(http.StatusUnauthorized, code)
+	challenge := "Bearer"
+	if errorCode != "" {
+		challenge = fmt.Sprintf(`Bearer error=%q, error_description=%q`, errorCode, problem.title(i18n.Default))
+	}
+	ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, challenge)
+	return problem
+}
+
+func forbidden(ctx echo.Context) error {
+	metrics.TokenValidationFailures.WithLabelValues(codeInsufficientScope).Inc()
+	problem := newError(http.StatusForbidden, codeInsufficientScope)
+	ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`Bearer error="insufficient_scope", error_description=%q`, problem.title(i18n.Default)))
+	return problem
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/metrics"
+	"github.com/SawitProRecruitment/UserService/passwordhash"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+// defaultPasswordHistory is how many of the latest passwords of a user,
+// including the current one, cannot be chosen again.
+const defaultPasswordHistory = 5
+
+// maxPasswordLength bounds the passwords that are hashed at login, whatever
+// the policy allows.
+const maxPasswordLength = 1024
+
+// Codes of violations, next to the codes of passwordpolicy.
+const (
+	codeInvalidPhoneNumber = "invalid_phone_number"
+	codeInvalidFullName    = "invalid_full_name"
+	codeInvalidLanguage    = "invalid_language"
+	codePasswordBreached   = "password_breached"
+)
+
+// passwordViolations checks a new password, sent in the given request
+// field, against the server's policy and the list of breached passwords.
+func (s *Server) passwordViolations(field, password, fullName, phoneNumber string) []violation {
+	var violations []violation
+	for _, v := range s.PasswordPolicy.Check(password, passwordpolicy.User{FullName: fullName, PhoneNumber: phoneNumber}) {
+		var args []interface{}
+		if v.Limit > 0 {
+			args = []interface{}{v.Limit}
+		}
+		violations = append(violations, violation{Field: field, Code: v.Code, Args: args})
+	}
+	if s.BreachedPasswords != nil && s.BreachedPasswords.Contains(password) {
+		violations = append(violations, violation{Field: field, Code: codePasswordBreached})
+	}
+	return violations
+}
+
+// validationError answers 400 Bad Request with every rule the request broke.
+func validationError(violations []violation) error {
+	return &Error{
+		Status:     http.StatusBadRequest,
+		Code:       codeValidationFailed,
+		Violations: violations,
+	}
+}
+
+// hashPassword hashes a new password under the server's hashing policy.
+func (s *Server) hashPassword(password string) ([]byte, error) {
+	defer metrics.ObserveHash(metrics.HashOperationHash, string(s.PasswordHashing.Algorithm), time.Now())
+	hash, err := s.PasswordHashing.Hash(password)
+	return []byte(hash), err
+}
+
+// checkPassword reports whether password matches a stored hash of any
+// supported format. Hashes that cannot be read never match.
+func checkPassword(hash, password string) bool {
+	defer metrics.ObserveHash(metrics.HashOperationVerify, string(passwordhash.AlgorithmOf(hash)), time.Now())
+	ok, err := passwordhash.Verify(password, hash)
+	if err != nil && hash != "" {
+		log.Println("failed to verify password hash:", err)
+	}
+	return ok
+}
+
+// rehashPassword replaces the stored hash of a user who just proved their
+// password when it was made under older hashing settings. Failing to do so
+// does not fail the request, the next login tries again.
+func (s *Server) rehashPassword(ctx context.Context, user repository.QueryOutput, password string) {
+	if !s.PasswordHashing.NeedsRehash(user.Password) {
+		return
+	}
+	hash, err := s.hashPassword(password)
+	if err != nil {
+		log.Println("failed to rehash password:", err)
+		return
+	}
+	err = s.Repository.RehashPassword(ctx, user.ID, user.Password, string(hash))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println("failed to store rehashed password:", err)
+	}
+}
+
+// passwordUsedRecently reports whether password matches one of the latest
+// passwords of the user. It is always false when the history is off.
+func (s *Server) passwordUsedRecently(ctx context.Context, userID int, password string) (bool, error) {
+	if s.PasswordHistory <= 0 {
+		return false, nil
+	}
+	hashes, err := s.Repository.GetPasswordHistory(ctx, userID, s.PasswordHistory)
+	if err != nil {
+		return false, err
+	}
+	for _, hash := range hashes {
+		if checkPassword(hash, password) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/i18n"
+	"github.com/SawitProRecruitment/UserService/otp"
+	"github.com/SawitProRecruitment/UserService/phone"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// otpPurposeVerifyPhone proves that a user owns the phone number they
+	// signed up with.
+	otpPurposeVerifyPhone = "verify_phone"
+	// otpPurposeChangePhone proves that a user owns the phone number they
+	// want to change to.
+	otpPurposeChangePhone = "change_phone"
+	// otpPurposeResetPassword proves that a user who forgot their password
+	// owns the verified phone number of the account.
+	otpPurposeResetPassword = "reset_password"
+)
+
+// defaultPhoneRegion is the country of phone numbers written without a
+// country code.
+const defaultPhoneRegion = "ID"
+
+// normalizePhoneNumber returns raw in E.164 format, the form phone numbers
+// are stored in. Numbers without a country code are read as numbers of the
+// server's region, so "0812..." and "+62 812-..." are the same number.
+func (s *Server) normalizePhoneNumber(raw string) (string, bool) {
+	normalized, err := phone.Normalize(raw, s.PhoneRegion)
+	return normalized, err == nil
+}
+
+// otpSubject is what an OTP is issued for. Codes only match the subject they
+// were hashed with.
+func otpSubject(purpose string, userID int, phoneNumber string) string {
+	return purpose + ":" + strconv.Itoa(userID) + ":" + phoneNumber
+}
+
+// sendOTP issues a new code for the purpose and texts it to phoneNumber in
+// lang. A new code can only be requested once the policy's resend interval
+// passed.
+func (s *Server) sendOTP(ctx echo.Context, lang i18n.Language, userID int, purpose, phoneNumber string) error {
+	now := time.Now()
+	previous, err := s.Repository.GetOTP(ctx.Request().Context(), userID, purpose)
+	if err == nil {
+		wait := s.OTP.ResendAfter(otp.Code{CreatedAt: previous.CreatedAt, ConsumedAt: previous.ConsumedAt}, now)
+		if wait > 0 {
+			ctx.Response().Header().Set("Retry-After", strconv.Itoa(int((wait+time.Second-1)/time.Second)))
+			return newError(http.StatusTooManyRequests, codeVerificationCodeSentRecently)
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return internalError(msgSendCodeFailed)
+	}
+
+	code, err := s.OTP.Generate()
+	if err != nil {
+		return internalError(msgSendCodeFailed)
+	}
+	err = s.Repository.SaveOTP(ctx.Request().Context(), repository.OTPInput{
+		UserID:      userID,
+		Purpose:     purpose,
+		PhoneNumber: phoneNumber,
+		CodeHash:    otp.Hash(otpSubject(purpose, userID, phoneNumber), code),
+		ExpiresAt:   now.Add(s.OTP.TTL),
+	})
+	if err != nil {
+		return internalError(msgSendCodeFailed)
+	}
+
+	text := messages.Text(lang, msgVerificationCodeSMS, code, int(s.OTP.TTL/time.Minute))
+	if err := s.SMS.Send(ctx.Request().Context(), phoneNumber, text); err != nil {
+		log.Println("failed to send sms:", err)
+		return newError(http.StatusBadGateway, codeSMSDeliveryFailed)
+	}
+	return nil
+}
+
+// checkOTP verifies a code the user received for the purpose and uses it
+// up. Every check counts as an attempt, whether the code matches or not.
+func (s *Server) checkOTP(ctx echo.Context, userID int, purpose, code string) (repository.OTPOutput, error) {
+	stored, err := s.Repository.GetOTP(ctx.Request().Context(), userID, purpose)
+	if errors.Is(err, sql.ErrNoRows) {
+		return stored, newError(http.StatusBadRequest, codeVerificationCodeInvalid)
+	}
+	if err != nil {
+		return stored, internalError(msgVerifyCodeFailed)
+	}
+	// Guesses at a code are limited per phone number too, across every
+	// account that asked for a code to it.
+	if err := s.limitPhoneNumber(ctx, stored.PhoneNumber); err != nil {
+		return stored, err
+	}
+
+	attempts, err := s.Repository.CountOTPAttempt(ctx.Request().Context(), stored.ID)
+	if err != nil {
+		return stored, internalError(msgVerifyCodeFailed)
+	}
+	c := otp.Code{
+		Hash:       stored.CodeHash,
+		Attempts:   attempts,
+		CreatedAt:  stored.CreatedAt,
+		ExpiresAt:  stored.ExpiresAt,
+		ConsumedAt: stored.ConsumedAt,
+	}
+	err = s.OTP.Usable(c, time.Now())
+	if err == nil {
+		err = otp.Match(c, otpSubject(purpose, userID, stored.PhoneNumber), code)
+	}
+	switch {
+	case errors.Is(err, otp.ErrExpired), errors.Is(err, otp.ErrUsed):
+		return stored, newError(http.StatusBadRequest, codeVerificationCodeExpired)
+	case errors.Is(err, otp.ErrTooManyAttempts):
+		return stored, newError(http.StatusBadRequest, codeVerificationCodeAttemptsExceeded)
+	case err != nil:
+		return stored, newError(http.StatusBadRequest, codeVerificationCodeInvalid)
+	}
+
+	consumed, err := s.Repository.ConsumeOTP(ctx.Request().Context(), stored.ID)
+	if err != nil {
+		return stored, internalError(msgVerifyCodeFailed)
+	}
+	if !consumed {
+		return stored, newError(http.StatusBadRequest, codeVerificationCodeExpired)
+	}
+	return stored, nil
+}
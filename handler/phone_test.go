@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/otp"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSMSSender records the messages instead of sending them.
+type testSMSSender struct {
+	messages []string
+}
+
+func (t *testSMSSender) Send(ctx context.Context, phoneNumber, message string) error {
+	t.messages = append(t.messages, phoneNumber+": "+message)
+	return nil
+}
+
+// otpInputMatcher matches a repository.OTPInput by everything but the
+// random code.
+type otpInputMatcher struct {
+	userID      int
+	purpose     string
+	phoneNumber string
+}
+
+func otpInputFor(userID int, purpose, phoneNumber string) gomock.Matcher {
+	return otpInputMatcher{userID: userID, purpose: purpose, phoneNumber: phoneNumber}
+}
+
+func (m otpInputMatcher) Matches(x interface{}) bool {
+	input, ok := x.(repository.OTPInput)
+	return ok && input.UserID == m.userID && input.Purpose == m.purpose && input.PhoneNumber == m.phoneNumber &&
+		len(input.CodeHash) == 64 && input.ExpiresAt.After(time.Now())
+}
+
+func (m otpInputMatcher) String() string {
+	return fmt.Sprintf("OTP for user %d to %s %s", m.userID, m.purpose, m.phoneNumber)
+}
+
+func Test_PostPhoneVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	pn := "+62888732928"
+	tests := []struct {
+		name     string
+		mockFunc func()
+		sent     int
+		text     string
+		err      string
+	}{
+		{
+			name: "unverified phone number",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(repository.OTPOutput{CreatedAt: time.Now().Add(-2 * time.Minute)}, nil)
+				mockRepo.EXPECT().SaveOTP(gomock.Any(), otpInputFor(1, otpPurposeVerifyPhone, pn)).Return(nil)
+			},
+			sent: 1,
+			text: "Your verification code is",
+		},
+		{
+			name: "in the language of the user",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1, Language: "id"}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(repository.OTPOutput{}, sql.ErrNoRows)
+				mockRepo.EXPECT().SaveOTP(gomock.Any(), otpInputFor(1, otpPurposeVerifyPhone, pn)).Return(nil)
+			},
+			sent: 1,
+			text: "Kode verifikasi Anda adalah",
+		},
+		{
+			name: "verified phone number",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1, PhoneVerified: true}, nil)
+			},
+		},
+		{
+			name: "unknown phone number",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{}, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "requested recently",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(repository.OTPOutput{CreatedAt: time.Now().Add(-30 * time.Second)}, nil)
+			},
+			err: "code=429, message=Verification code was sent recently",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+
+			test.mockFunc()
+			sender := &testSMSSender{}
+			s := Server{
+				Repository: mockRepo,
+				SMS:        sender,
+				OTP:        otp.DefaultPolicy,
+			}
+			err := s.PostPhoneVerification(c, generated.PostPhoneVerificationParams{PhoneNumber: pn})
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				assert.Equal(t, "30", rec.Header().Get("Retry-After"))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, `{"message":"Verification code sent if the phone number needs one"}`, strings.TrimSpace(rec.Body.String()))
+			assert.Len(t, sender.messages, test.sent)
+			if test.text != "" && len(sender.messages) > 0 {
+				assert.Contains(t, sender.messages[0], test.text)
+			}
+		})
+	}
+}
+
+func Test_PostPhoneVerificationConfirm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	pn := "+62888732928"
+	stored := repository.OTPOutput{
+		ID:          7,
+		UserID:      1,
+		Purpose:     otpPurposeVerifyPhone,
+		PhoneNumber: pn,
+		CodeHash:    otp.Hash(otpSubject(otpPurposeVerifyPhone, 1, pn), "123456"),
+		CreatedAt:   time.Now(),
+		ExpiresAt:   time.Now().Add(time.Minute),
+	}
+	expired := stored
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	tests := []struct {
+		name     string
+		code     string
+		mockFunc func()
+		body     string
+		err      string
+	}{
+		{
+			name: "success",
+			code: "123456",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(stored, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 7).Return(1, nil)
+				mockRepo.EXPECT().ConsumeOTP(gomock.Any(), 7).Return(true, nil)
+				mockRepo.EXPECT().VerifyPhone(gomock.Any(), 1, pn).Return(nil)
+			},
+			body: `{"message":"Successfully verified phone number"}`,
+		},
+		{
+			name: "wrong code",
+			code: "654321",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(stored, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 7).Return(1, nil)
+			},
+			err: "code=400, message=Verification code is not valid",
+		},
+		{
+			name: "out of attempts",
+			code: "123456",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(stored, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 7).Return(otp.DefaultPolicy.MaxAttempts+1, nil)
+			},
+			err: "code=400, message=Too many attempts, request a new verification code",
+		},
+		{
+			name: "expired code",
+			code: "123456",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(expired, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 7).Return(1, nil)
+			},
+			err: "code=400, message=Verification code has expired",
+		},
+		{
+			name: "no code requested",
+			code: "123456",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1}, nil)
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeVerifyPhone).Return(repository.OTPOutput{}, sql.ErrNoRows)
+			},
+			err: "code=400, message=Verification code is not valid",
+		},
+		{
+			name: "already verified",
+			code: "123456",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 1, PhoneVerified: true}, nil)
+			},
+			body: `{"message":"Phone number is already verified"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+
+			test.mockFunc()
+			s := Server{
+				Repository: mockRepo,
+				OTP:        otp.DefaultPolicy,
+			}
+			err := s.PostPhoneVerificationConfirm(c, generated.PostPhoneVerificationConfirmParams{PhoneNumber: pn, Code: test.code})
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.body, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func Test_PostMyPhoneConfirm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	pn := "+62888732929"
+	stored := repository.OTPOutput{
+		ID:          8,
+		UserID:      1,
+		Purpose:     otpPurposeChangePhone,
+		PhoneNumber: pn,
+		CodeHash:    otp.Hash(otpSubject(otpPurposeChangePhone, 1, pn), "123456"),
+		CreatedAt:   time.Now(),
+		ExpiresAt:   time.Now().Add(time.Minute),
+	}
+	tests := []struct {
+		name     string
+		mockFunc func()
+		err      string
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeChangePhone).Return(stored, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 8).Return(1, nil)
+				mockRepo.EXPECT().ConsumeOTP(gomock.Any(), 8).Return(true, nil)
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{}, sql.ErrNoRows)
+				mockRepo.EXPECT().VerifyPhone(gomock.Any(), 1, pn).Return(nil)
+			},
+		},
+		{
+			name: "phone number taken meanwhile",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeChangePhone).Return(stored, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 8).Return(1, nil)
+				mockRepo.EXPECT().ConsumeOTP(gomock.Any(), 8).Return(true, nil)
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: pn}).Return(repository.QueryOutput{ID: 2}, nil)
+			},
+			err: "code=409, message=Phone number already exists",
+		},
+		{
+			name: "code used concurrently",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 1, otpPurposeChangePhone).Return(stored, nil)
+				mockRepo.EXPECT().CountOTPAttempt(gomock.Any(), 8).Return(1, nil)
+				mockRepo.EXPECT().ConsumeOTP(gomock.Any(), 8).Return(false, nil)
+			},
+			err: "code=400, message=Verification code has expired",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: 1}))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			test.mockFunc()
+			s := Server{
+				Repository: mockRepo,
+				OTP:        otp.DefaultPolicy,
+			}
+			err := s.PostMyPhoneConfirm(c, generated.PostMyPhoneConfirmParams{Code: "123456"})
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, `{"message":"Successfully changed phone number"}`, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/i18n"
+	"github.com/labstack/echo/v4"
+)
+
+// mimeProblemJSON is the media type of RFC 7807 problem details.
+const mimeProblemJSON = "application/problem+json"
+
+// problemTypePrefix turns the code of a problem into the URI of its type.
+const problemTypePrefix = "urn:problem-type:user-service:"
+
+// Codes of the problems answered by the handlers. Clients branch on them, so
+// a released code must never change its meaning.
+const (
+	codeInvalidRequest                   = "invalid_request"
+	codeValidationFailed                 = "validation_failed"
+	codeMalformedBody                    = "malformed_body"
+	codeUnsupportedMediaType             = "unsupported_media_type"
+	codeRequestTooLarge                  = "request_too_large"
+	codeAuthorizationMissing             = "authorization_missing"
+	codeTokenInvalid                     = "token_invalid"
+	codeTokenRevoked                     = "token_revoked"
+	codeInsufficientScope                = "insufficient_scope"
+	codeInvalidCredentials               = "invalid_credentials"
+	codeAccountLocked                    = "account_locked"
+	codeAccountExists                    = "account_exists"
+	codeProfileNotFound                  = "profile_not_found"
+	codeUserNotFound                     = "user_not_found"
+	codePhoneNumberTaken                 = "phone_number_taken"
+	codeCurrentPasswordInvalid           = "current_password_invalid"
+	codePasswordReused                   = "password_reused"
+	codeRefreshTokenInvalid              = "refresh_token_invalid"
+	codeMFAChallengeInvalid              = "mfa_challenge_invalid"
+	codeMFACodeInvalid                   = "mfa_code_invalid"
+	codeMFAAlreadyEnabled                = "mfa_already_enabled"
+	codeMFANotEnabled                    = "mfa_not_enabled"
+	codeMFAEnrollmentNotStarted          = "mfa_enrollment_not_started"
+	codeVerificationCodeInvalid          = "verification_code_invalid"
+	codeVerificationCodeExpired          = "verification_code_expired"
+	codeVerificationCodeAttemptsExceeded = "verification_code_attempts_exceeded"
+	codeVerificationCodeSentRecently     = "verification_code_sent_recently"
+	codeSMSDeliveryFailed                = "sms_delivery_failed"
+	codeRateLimited                      = "rate_limited"
+	codeNotFound                         = "not_found"
+	codeMethodNotAllowed                 = "method_not_allowed"
+	codeInternalError                    = "internal_error"
+)
+
+// Error is an error that HTTPErrorHandler answers as an RFC 7807 problem.
+// Its texts are looked up in messages in the language of the request.
+type Error struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Code is the stable code of the problem, which also names its type
+	// and title.
+	Code string
+	// Detail is the message key explaining this occurrence of the problem,
+	// if the title is not enough. Text without a key, such as the errors
+	// of echo itself, is shown as it is.
+	Detail string
+	// Violations lists every rule the fields of the request broke.
+	Violations []violation
+}
+
+// violation is a rule that a field of the request broke. Its message is
+// looked up by code.
+type violation struct {
+	Field string
+	Code  string
+	// Args formats the message of rules that have a bound.
+	Args []interface{}
+}
+
+// newError returns the problem of the given code.
+func newError(status int, code string) *Error {
+	return &Error{Status: status, Code: code}
+}
+
+// internalError returns a 500 Internal Server Error whose detail says what
+// the server failed to do.
+func internalError(detail string) *Error {
+	return &Error{Status: http.StatusInternalServerError, Code: codeInternalError, Detail: detail}
+}
+
+// Error formats the error the way echo.HTTPError does, so logs read the same.
+func (e *Error) Error() string {
+	message := e.detail(i18n.Default)
+	if message == "" {
+		message = e.title(i18n.Default)
+	}
+	return fmt.Sprintf("code=%d, message=%s", e.Status, message)
+}
+
+// title returns the summary of the problem code in lang.
+func (e *Error) title(lang i18n.Language) string {
+	if _, ok := messages[e.Code]; ok {
+		return messages.Text(lang, e.Code)
+	}
+	return http.StatusText(e.Status)
+}
+
+// detail returns the explanation of the problem in lang, which joins the
+// messages of the violations for clients that only show one message.
+func (e *Error) detail(lang i18n.Language) string {
+	if len(e.Violations) == 0 {
+		return messages.Text(lang, e.Detail)
+	}
+	texts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		texts[i] = messages.Text(lang, v.Code, v.Args...)
+	}
+	return strings.Join(texts, ", ")
+}
+
+// asProblem turns any error returned by a handler or middleware into a
+// problem. Errors of echo itself, such as unknown routes or malformed
+// parameters, get a code derived from their status.
+func asProblem(err error) *Error {
+	var problem *Error
+	if errors.As(err, &problem) {
+		return problem
+	}
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Code >= http.StatusInternalServerError {
+		return internalError("")
+	}
+
+	problem = &Error{Status: httpErr.Code}
+	switch httpErr.Code {
+	case http.StatusBadRequest:
+		problem.Code = codeInvalidRequest
+	case http.StatusTooManyRequests:
+		problem.Code = codeRateLimited
+	default:
+		problem.Code = strings.ReplaceAll(strings.ToLower(http.StatusText(httpErr.Code)), " ", "_")
+	}
+	if message, ok := httpErr.Message.(string); ok && message != http.StatusText(httpErr.Code) {
+		problem.Detail = message
+	}
+	return problem
+}
+
+// HTTPErrorHandler answers the errors of handlers and middleware with an
+// application/problem+json body. It replaces echo's default error handler.
+func (s *Server) HTTPErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	problem := asProblem(err)
+	requestID := ctx.Response().Header().Get(echo.HeaderXRequestID)
+	if problem.Status >= http.StatusInternalServerError {
+		log.Printf("request %s to %s failed: %v", requestID, ctx.Request().URL.Path, err)
+	}
+
+	lang := language(ctx)
+	body := generated.Problem{
+		Type:   problemTypePrefix + problem.Code,
+		Title:  problem.title(lang),
+		Status: problem.Status,
+		Code:   problem.Code,
+	}
+	if detail := problem.detail(lang); detail != "" {
+		body.Detail = &detail
+	}
+	if path := ctx.Request().URL.Path; path != "" {
+		body.Instance = &path
+	}
+	if requestID != "" {
+		body.RequestId = &requestID
+	}
+	if len(problem.Violations) > 0 {
+		violations := make([]generated.Violation, len(problem.Violations))
+		for i, v := range problem.Violations {
+			violations[i] = generated.Violation{
+				Field:   v.Field,
+				Code:    v.Code,
+				Message: messages.Text(lang, v.Code, v.Args...),
+			}
+		}
+		body.Violations = &violations
+	}
+
+	ctx.Response().Header().Set("Content-Language", string(lang))
+	ctx.Response().Header().Set(echo.HeaderContentType, mimeProblemJSON)
+	if ctx.Request().Method == http.MethodHead {
+		err = ctx.NoContent(problem.Status)
+	} else {
+		err = ctx.JSON(problem.Status, body)
+	}
+	if err != nil {
+		log.Println("failed to write error response:", err)
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HTTPErrorHandler(t *testing.T) {
+	type wantS struct {
+		code int
+		body string
+	}
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		err    error
+		want   wantS
+	}{
+		{
+			name:   "problem",
+			method: http.MethodGet,
+			path:   "/fail",
+			err:    newError(http.StatusNotFound, codeProfileNotFound),
+			want: wantS{
+				code: http.StatusNotFound,
+				body: `{"code":"profile_not_found","instance":"/fail","request_id":"req-1","status":404,"title":"Profile not found","type":"urn:problem-type:user-service:profile_not_found"}`,
+			},
+		},
+		{
+			name:   "validation",
+			method: http.MethodGet,
+			path:   "/fail",
+			err:    validationError([]violation{DefaultFullNameLength.violation()}),
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"validation_failed","detail":"Full name must be between 3 and 60 characters","instance":"/fail","request_id":"req-1","status":400,"title":"Request data is not valid","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"invalid_full_name","field":"full_name","message":"Full name must be between 3 and 60 characters"}]}`,
+			},
+		},
+		{
+			name:   "internal error",
+			method: http.MethodGet,
+			path:   "/fail",
+			err:    internalError(msgChangePasswordFailed),
+			want: wantS{
+				code: http.StatusInternalServerError,
+				body: `{"code":"internal_error","detail":"Failed to change password","instance":"/fail","request_id":"req-1","status":500,"title":"Internal server error","type":"urn:problem-type:user-service:internal_error"}`,
+			},
+		},
+		{
+			name:   "unexpected error",
+			method: http.MethodGet,
+			path:   "/fail",
+			err:    errors.New("pq: connection refused"),
+			want: wantS{
+				code: http.StatusInternalServerError,
+				body: `{"code":"internal_error","instance":"/fail","request_id":"req-1","status":500,"title":"Internal server error","type":"urn:problem-type:user-service:internal_error"}`,
+			},
+		},
+		{
+			name:   "echo error",
+			method: http.MethodGet,
+			path:   "/fail",
+			err:    echo.NewHTTPError(http.StatusBadRequest, "Invalid format for parameter id"),
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"invalid_request","detail":"Invalid format for parameter id","instance":"/fail","request_id":"req-1","status":400,"title":"Invalid request data","type":"urn:problem-type:user-service:invalid_request"}`,
+			},
+		},
+		{
+			name:   "unknown route",
+			method: http.MethodGet,
+			path:   "/missing",
+			want: wantS{
+				code: http.StatusNotFound,
+				body: `{"code":"not_found","instance":"/missing","request_id":"req-1","status":404,"title":"Not found","type":"urn:problem-type:user-service:not_found"}`,
+			},
+		},
+		{
+			name:   "unknown method",
+			method: http.MethodPost,
+			path:   "/fail",
+			want: wantS{
+				code: http.StatusMethodNotAllowed,
+				body: `{"code":"method_not_allowed","instance":"/fail","request_id":"req-1","status":405,"title":"Method not allowed","type":"urn:problem-type:user-service:method_not_allowed"}`,
+			},
+		},
+		{
+			name:   "head request",
+			method: http.MethodHead,
+			path:   "/fail",
+			err:    newError(http.StatusNotFound, codeProfileNotFound),
+			want: wantS{
+				code: http.StatusNotFound,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Server{}
+			e := echo.New()
+			e.HTTPErrorHandler = s.HTTPErrorHandler
+			e.Use(RequestIDMiddleware())
+			e.GET("/fail", func(ctx echo.Context) error {
+				return test.err
+			})
+			e.HEAD("/fail", func(ctx echo.Context) error {
+				return test.err
+			})
+
+			req := httptest.NewRequest(test.method, test.path, nil)
+			req.Header.Set(echo.HeaderXRequestID, "req-1")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.want.code, rec.Code)
+			assert.Equal(t, mimeProblemJSON, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, "req-1", rec.Header().Get(echo.HeaderXRequestID))
+			assert.Equal(t, test.want.body, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func Test_RequestIDMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(RequestIDMiddleware())
+	e.GET("/", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	tests := []struct {
+		name      string
+		requestID string
+		kept      bool
+	}{
+		{name: "from proxy", requestID: "3f2c-41aa", kept: true},
+		{name: "missing", requestID: ""},
+		{name: "too long", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+		{name: "not printable", requestID: "a b\x01"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(echo.HeaderXRequestID, test.requestID)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(echo.HeaderXRequestID)
+			if test.kept {
+				assert.Equal(t, test.requestID, got)
+				return
+			}
+			assert.Len(t, got, 32)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/ratelimit"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimitMiddleware answers 429 Too Many Requests with a Retry-After
+// header once a client exceeds the limits of a route. The store is asked for
+// every limit of the route so that all buckets are charged. When the store
+// fails the request is let through, as an outage of the store should not
+// take login down with it.
+func (s *Server) RateLimitMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			route := rateLimitRoute(ctx)
+			rule, ok := s.RateLimits[route]
+			if !ok || s.RateLimiter == nil {
+				return next(ctx)
+			}
+
+			var retryAfter time.Duration
+			if rule.PerIP.Enabled() {
+				retryAfter = s.takeRateLimitToken(ctx, route+" ip:"+ctx.RealIP(), rule.PerIP)
+			}
+			if phoneNumber := requestPhoneNumber(ctx); phoneNumber != "" && rule.PerPhone.Enabled() {
+				// Every way of writing a number shares its bucket.
+				if normalized, ok := s.normalizePhoneNumber(phoneNumber); ok {
+					phoneNumber = normalized
+				}
+				if wait := s.takeRateLimitToken(ctx, route+" phone:"+phoneNumber, rule.PerPhone); wait > retryAfter {
+					retryAfter = wait
+				}
+			}
+
+			if retryAfter > 0 {
+				return rateLimited(ctx, retryAfter)
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// limitPhoneNumber charges the per-phone limit of the route for the number a
+// code was texted to, when the request does not name it for
+// RateLimitMiddleware to charge.
+func (s *Server) limitPhoneNumber(ctx echo.Context, phoneNumber string) error {
+	route := rateLimitRoute(ctx)
+	rule, ok := s.RateLimits[route]
+	if !ok || s.RateLimiter == nil || !rule.PerPhone.Enabled() || requestPhoneNumber(ctx) != "" {
+		return nil
+	}
+	if retryAfter := s.takeRateLimitToken(ctx, route+" phone:"+phoneNumber, rule.PerPhone); retryAfter > 0 {
+		return rateLimited(ctx, retryAfter)
+	}
+	return nil
+}
+
+// takeRateLimitToken takes a token from the bucket of key and returns how
+// long to wait when there was none left.
+func (s *Server) takeRateLimitToken(ctx echo.Context, key string, limit ratelimit.Limit) time.Duration {
+	output, err := s.RateLimiter.TakeRateLimitToken(ctx.Request().Context(), repository.RateLimitInput{
+		Key:      key,
+		Capacity: limit.Requests,
+		Period:   limit.Per,
+	})
+	if err != nil {
+		log.Println("failed to take rate limit token:", err)
+		return 0
+	}
+	if output.Allowed {
+		return 0
+	}
+	return output.RetryAfter
+}
+
+func rateLimited(ctx echo.Context, retryAfter time.Duration) error {
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	ctx.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+	return newError(http.StatusTooManyRequests, codeRateLimited)
+}
+
+// v2Routes maps the /v2 routes whose path differs from the v1 route they
+// stand for onto it.
+var v2Routes = map[string]string{
+	"PATCH /me":              "PATCH /update-my-profile",
+	"POST /me/phone/confirm": "POST /my-phone/confirm",
+}
+
+// rateLimitRoute returns the route the limits of a request are keyed by. The
+// /v2 routes share the limits, and the buckets, of their v1 routes.
+func rateLimitRoute(ctx echo.Context) string {
+	route := ctx.Request().Method + " " + strings.TrimPrefix(ctx.Path(), "/v2")
+	if v1, ok := v2Routes[route]; ok {
+		return v1
+	}
+	return route
+}
+
+// requestPhoneNumber returns the phone number of a request, from the query
+// string of v1 routes or the JSON body of /v2 routes. The body is put back
+// for the handler to read.
+func requestPhoneNumber(ctx echo.Context) string {
+	if phoneNumber := ctx.QueryParam("phone_number"); phoneNumber != "" {
+		return phoneNumber
+	}
+	req := ctx.Request()
+	if req.Body == nil || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return ""
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+	var fields struct {
+		PhoneNumber string `json:"phone_number"`
+	}
+	if json.Unmarshal(body, &fields) != nil {
+		return ""
+	}
+	return fields.PhoneNumber
+}
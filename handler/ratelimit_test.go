@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/ratelimit"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RateLimitMiddleware(t *testing.T) {
+	type request struct {
+		ip    string
+		phone string
+	}
+	type wantS struct {
+		code       int
+		retryAfter string
+	}
+	tests := []struct {
+		name     string
+		requests []request
+		want     []wantS
+	}{
+		{
+			name: "per ip",
+			requests: []request{
+				{ip: "10.0.0.1", phone: "+62888732928"},
+				{ip: "10.0.0.1", phone: "+62888732929"},
+				{ip: "10.0.0.1", phone: "+62888732930"},
+				{ip: "10.0.0.2", phone: "+62888732931"},
+			},
+			want: []wantS{
+				{code: http.StatusOK},
+				{code: http.StatusOK},
+				{code: http.StatusTooManyRequests, retryAfter: "30"},
+				{code: http.StatusOK},
+			},
+		},
+		{
+			name: "per phone",
+			requests: []request{
+				{ip: "10.0.0.1", phone: "+62888732928"},
+				{ip: "10.0.0.2", phone: "+62888732928"},
+				{ip: "10.0.0.3", phone: "+62888732929"},
+			},
+			want: []wantS{
+				{code: http.StatusOK},
+				{code: http.StatusTooManyRequests, retryAfter: "3600"},
+				{code: http.StatusOK},
+			},
+		},
+		{
+			name: "per phone in any format",
+			requests: []request{
+				{ip: "10.0.0.1", phone: "0888732928"},
+				{ip: "10.0.0.2", phone: "%2B62%20888-732-928"},
+			},
+			want: []wantS{
+				{code: http.StatusOK},
+				{code: http.StatusTooManyRequests, retryAfter: "3600"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Server{
+				PhoneRegion: "ID",
+				RateLimiter: repository.NewMemoryRateLimitStore(),
+				RateLimits: map[string]ratelimit.Rule{
+					"POST /login": {
+						PerIP:    ratelimit.Limit{Requests: 2, Per: time.Minute},
+						PerPhone: ratelimit.Limit{Requests: 1, Per: time.Hour},
+					},
+				},
+			}
+			e := echo.New()
+			e.HTTPErrorHandler = s.HTTPErrorHandler
+			e.IPExtractor = echo.ExtractIPDirect()
+			e.POST("/login", func(ctx echo.Context) error {
+				return ctx.NoContent(http.StatusOK)
+			}, s.RateLimitMiddleware())
+
+			for i, r := range test.requests {
+				req := httptest.NewRequest(http.MethodPost, "/login?phone_number="+r.phone, nil)
+				req.RemoteAddr = r.ip + ":1234"
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+
+				assert.Equal(t, test.want[i].code, rec.Code, "request %d", i)
+				assert.Equal(t, test.want[i].retryAfter, rec.Header().Get("Retry-After"), "request %d", i)
+			}
+		})
+	}
+}
+
+func Test_limitPhoneNumber(t *testing.T) {
+	s := &Server{
+		RateLimiter: repository.NewMemoryRateLimitStore(),
+		RateLimits: map[string]ratelimit.Rule{
+			"POST /my-phone/confirm": {PerPhone: ratelimit.Limit{Requests: 1, Per: time.Hour}},
+		},
+	}
+	e := echo.New()
+	e.HTTPErrorHandler = s.HTTPErrorHandler
+	confirm := func(ctx echo.Context) error {
+		if err := s.limitPhoneNumber(ctx, "+62888732928"); err != nil {
+			return err
+		}
+		return ctx.NoContent(http.StatusOK)
+	}
+	e.POST("/my-phone/confirm", confirm)
+	e.POST("/v2/me/phone/confirm", confirm)
+
+	// The v2 route shares the bucket of the v1 route.
+	requests := []struct {
+		path string
+		code int
+	}{
+		{path: "/my-phone/confirm", code: http.StatusOK},
+		{path: "/v2/me/phone/confirm", code: http.StatusTooManyRequests},
+	}
+	for _, r := range requests {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, r.path, nil))
+		assert.Equal(t, r.code, rec.Code, r.path)
+	}
+}
+
+func Test_RateLimitMiddleware_StoreError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := repository.NewMockRateLimitStoreInterface(ctrl)
+	mockStore.EXPECT().TakeRateLimitToken(gomock.Any(), gomock.Any()).Return(repository.RateLimitOutput{}, errors.New("connection refused"))
+
+	s := &Server{
+		RateLimiter: mockStore,
+		RateLimits: map[string]ratelimit.Rule{
+			"POST /signup": {PerIP: ratelimit.Limit{Requests: 1, Per: time.Minute}},
+		},
+	}
+	e := echo.New()
+	e.POST("/signup", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	}, s.RateLimitMiddleware())
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/signup", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
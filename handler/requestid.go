@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxRequestIDLength bounds the request IDs accepted from proxies.
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware gives every request an ID, sent back in the
+// X-Request-Id header and in problem responses so that a failed request can
+// be found in the logs. An ID set by a proxy in front of the service is kept.
+func RequestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			id := ctx.Request().Header.Get(echo.HeaderXRequestID)
+			if !validRequestID(id) {
+				id = newRequestID()
+			}
+			ctx.Response().Header().Set(echo.HeaderXRequestID, id)
+			return next(ctx)
+		}
+	}
+}
+
+// validRequestID reports whether id is short and printable enough to be
+// echoed back and logged.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
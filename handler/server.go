@@ -1,19 +1,126 @@
 package handler
 
 import (
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/breached"
+	"github.com/SawitProRecruitment/UserService/keys"
+	"github.com/SawitProRecruitment/UserService/otp"
+	"github.com/SawitProRecruitment/UserService/passwordhash"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/SawitProRecruitment/UserService/ratelimit"
 	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/SawitProRecruitment/UserService/sms"
 )
 
 type Server struct {
-	Repository repository.RepositoryInterface
+	Repository      repository.RepositoryInterface
+	Revocations     repository.RevocationStoreInterface
+	Keys            *keys.KeySet
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	Lockout         LockoutPolicy
+	RateLimiter     repository.RateLimitStoreInterface
+	RateLimits      map[string]ratelimit.Rule
+	TOTPIssuer      string
+	SMS             sms.SMSSender
+	OTP             otp.Policy
+	PhoneRegion     string
+	// PasswordHistory is how many of the latest passwords of a user cannot
+	// be chosen again. Zero lets any password be reused.
+	PasswordHistory int
+	PasswordHashing passwordhash.Policy
+	PasswordPolicy  passwordpolicy.Policy
+	FullNameLength  FullNameLength
+	// BreachedPasswords are rejected as new passwords. Nil checks none.
+	BreachedPasswords *breached.List
 }
 
 type NewServerOptions struct {
-	Repository repository.RepositoryInterface
+	Repository      repository.RepositoryInterface
+	Revocations     repository.RevocationStoreInterface
+	Keys            *keys.KeySet
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	Lockout         LockoutPolicy
+	RateLimiter     repository.RateLimitStoreInterface
+	RateLimits      map[string]ratelimit.Rule
+	TOTPIssuer      string
+	SMS             sms.SMSSender
+	OTP             otp.Policy
+	PhoneRegion     string
+	// PasswordHistory defaults to 5 when nil, and zero turns the check off.
+	PasswordHistory *int
+	PasswordHashing passwordhash.Policy
+	PasswordPolicy  passwordpolicy.Policy
+	FullNameLength  FullNameLength
+	// BreachedPasswords defaults to breached.Common.
+	BreachedPasswords *breached.List
 }
 
 func NewServer(opts NewServerOptions) *Server {
+	if opts.AccessTokenTTL == 0 {
+		opts.AccessTokenTTL = defaultAccessTokenTTL
+	}
+	if opts.RefreshTokenTTL == 0 {
+		opts.RefreshTokenTTL = defaultRefreshTokenTTL
+	}
+	if opts.Lockout == (LockoutPolicy{}) {
+		opts.Lockout = DefaultLockoutPolicy
+	}
+	if opts.TOTPIssuer == "" {
+		opts.TOTPIssuer = defaultTOTPIssuer
+	}
+	if opts.SMS == nil {
+		opts.SMS = sms.NoSender{}
+	}
+	if opts.PhoneRegion == "" {
+		opts.PhoneRegion = defaultPhoneRegion
+	}
+	if opts.OTP == (otp.Policy{}) {
+		opts.OTP = otp.DefaultPolicy
+	}
+	passwordHistory := defaultPasswordHistory
+	if opts.PasswordHistory != nil {
+		passwordHistory = *opts.PasswordHistory
+	}
+	if opts.PasswordHashing == (passwordhash.Policy{}) {
+		opts.PasswordHashing = passwordhash.DefaultPolicy
+	}
+	if opts.PasswordPolicy.MaxLength == 0 {
+		opts.PasswordPolicy = passwordpolicy.DefaultPolicy
+	}
+	// A password the algorithm cannot hash is refused as a violation
+	// rather than failing at hashing.
+	if limit := opts.PasswordHashing.MaxPasswordBytes(); limit > 0 && (opts.PasswordPolicy.MaxBytes == 0 || opts.PasswordPolicy.MaxBytes > limit) {
+		opts.PasswordPolicy.MaxBytes = limit
+	}
+	if opts.FullNameLength == (FullNameLength{}) {
+		opts.FullNameLength = DefaultFullNameLength
+	}
+	if opts.BreachedPasswords == nil {
+		opts.BreachedPasswords = breached.Common()
+	}
+	if opts.RateLimits == nil {
+		opts.RateLimits = ratelimit.Defaults
+	}
 	return &Server{
-		Repository: opts.Repository,
+		Repository:        opts.Repository,
+		Revocations:       opts.Revocations,
+		Keys:              opts.Keys,
+		AccessTokenTTL:    opts.AccessTokenTTL,
+		RefreshTokenTTL:   opts.RefreshTokenTTL,
+		Lockout:           opts.Lockout,
+		RateLimiter:       opts.RateLimiter,
+		RateLimits:        opts.RateLimits,
+		TOTPIssuer:        opts.TOTPIssuer,
+		SMS:               opts.SMS,
+		OTP:               opts.OTP,
+		PhoneRegion:       opts.PhoneRegion,
+		PasswordHistory:   passwordHistory,
+		PasswordHashing:   opts.PasswordHashing,
+		PasswordPolicy:    opts.PasswordPolicy,
+		FullNameLength:    opts.FullNameLength,
+		BreachedPasswords: opts.BreachedPasswords,
 	}
 }
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/passwordhash"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewServer_PasswordMaxBytes(t *testing.T) {
+	s := NewServer(NewServerOptions{})
+	assert.Equal(t, 0, s.PasswordPolicy.MaxBytes)
+
+	// bcrypt cannot hash longer passwords, so the policy refuses them.
+	s = NewServer(NewServerOptions{PasswordHashing: passwordhash.Policy{Algorithm: passwordhash.Bcrypt, BcryptCost: 10}})
+	assert.Equal(t, passwordhash.BcryptMaxBytes, s.PasswordPolicy.MaxBytes)
+	violations := s.passwordViolations("password", "Kebun-7"+strings.Repeat("é", 33), "", "")
+	if assert.Len(t, violations, 1) {
+		assert.Equal(t, passwordpolicy.CodeTooManyBytes, violations[0].Code)
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	// scopeAdmin grants access to the /admin operations.
+	scopeAdmin = "admin"
+)
+
+// issueTokens signs a new access token for the user and makes a new refresh
+// token, which the caller stores. An empty familyID starts a new token
+// family, as on login.
+func (s *Server) issueTokens(user repository.QueryOutput, familyID string) (generated.TokenResponse, repository.RefreshTokenInput, error) {
+	if familyID == "" {
+		familyID = uuid.NewString()
+	}
+
+	now := time.Now()
+	claims := &Claims{
+		SessionID: familyID,
+		Scope:     userScope(user),
+		Locale:    user.Language,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   strconv.Itoa(user.ID),
+			Id:        uuid.NewString(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(s.AccessTokenTTL).Unix(),
+		},
+	}
+	accessToken, err := s.Keys.Sign(claims)
+	if err != nil {
+		return generated.TokenResponse{}, repository.RefreshTokenInput{}, err
+	}
+
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return generated.TokenResponse{}, repository.RefreshTokenInput{}, err
+	}
+
+	return generated.TokenResponse{
+		Token:        accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(s.AccessTokenTTL / time.Second),
+		RefreshToken: refreshToken,
+	}, repository.RefreshTokenInput{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: now.Add(s.RefreshTokenTTL),
+	}, nil
+}
+
+// revokeUserSessions signs the user out everywhere: access tokens issued
+// before the given time are denied and every refresh token is revoked.
+func (s *Server) revokeUserSessions(ctx context.Context, userID int, before time.Time) error {
+	if err := s.Revocations.RevokeUserTokens(ctx, userID, before); err != nil {
+		return err
+	}
+	return s.Repository.RevokeUserRefreshTokens(ctx, userID)
+}
+
+// userScope returns the scopes granted to every token of the user.
+func userScope(user repository.QueryOutput) string {
+	if user.IsAdmin {
+		return scopeAdmin
+	}
+	return ""
+}
+
+// newRefreshToken returns an opaque random token. Only its hash is stored.
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/labstack/echo/v4"
+)
+
+// The /v2 routes take their fields from a JSON body instead of the query
+// string, so that credentials stay out of access logs and caches. They share
+// the logic of the v1 routes and only differ in how requests are read and in
+// status codes that v1 got wrong but cannot change anymore.
+
+var (
+	// errInvalidCredentials is answered with 400 by v1 and 401 by v2.
+	errInvalidCredentials = errors.New("invalid phone number or password")
+	// errAccountExists is answered with 400 by v1 and 409 by v2.
+	errAccountExists = errors.New("account already exists")
+)
+
+// bindBody decodes the JSON body of a /v2 request into v.
+func bindBody(ctx echo.Context, v interface{}) error {
+	err := (&echo.DefaultBinder{}).BindBody(ctx, v)
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) && httpErr.Code == http.StatusUnsupportedMediaType {
+		return newError(http.StatusUnsupportedMediaType, codeUnsupportedMediaType)
+	}
+	if err != nil {
+		return newError(http.StatusBadRequest, codeMalformedBody)
+	}
+	return nil
+}
+
+// PostV2Signup implements generated.ServerInterface.
+func (s *Server) PostV2Signup(ctx echo.Context) error {
+	var body generated.SignupRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+
+	id, err := s.signUp(ctx, body.PhoneNumber, body.FullName, body.Password)
+	if errors.Is(err, errAccountExists) {
+		return newError(http.StatusConflict, codeAccountExists)
+	}
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusCreated, generated.SignupResponse{
+		Id:      id,
+		Message: message(ctx, msgSignedUp),
+	})
+}
+
+// PostV2Login implements generated.ServerInterface.
+func (s *Server) PostV2Login(ctx echo.Context) error {
+	var body generated.LoginRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+
+	err := s.login(ctx, body.PhoneNumber, body.Password)
+	if errors.Is(err, errInvalidCredentials) {
+		return newError(http.StatusUnauthorized, codeInvalidCredentials)
+	}
+	return err
+}
+
+// PostV2LoginMfa implements generated.ServerInterface.
+func (s *Server) PostV2LoginMfa(ctx echo.Context) error {
+	var body generated.LoginMfaRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.PostLoginMfa(ctx, generated.PostLoginMfaParams{MfaToken: body.MfaToken, Code: body.Code})
+}
+
+// PostV2TokenRefresh implements generated.ServerInterface.
+func (s *Server) PostV2TokenRefresh(ctx echo.Context) error {
+	var body generated.RefreshTokenRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.PostTokenRefresh(ctx, generated.PostTokenRefreshParams{RefreshToken: body.RefreshToken})
+}
+
+// GetV2Me implements generated.ServerInterface.
+func (s *Server) GetV2Me(ctx echo.Context) error {
+	return s.GetMyProfile(ctx)
+}
+
+// PatchV2Me implements generated.ServerInterface.
+func (s *Server) PatchV2Me(ctx echo.Context) error {
+	var body generated.UpdateProfileRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.UpdateMyProfile(ctx, generated.UpdateMyProfileParams{PhoneNumber: body.PhoneNumber, FullName: body.FullName, Language: body.Language})
+}
+
+// PostV2MePhoneConfirm implements generated.ServerInterface.
+func (s *Server) PostV2MePhoneConfirm(ctx echo.Context) error {
+	var body generated.CodeRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.PostMyPhoneConfirm(ctx, generated.PostMyPhoneConfirmParams{Code: body.Code})
+}
+
+// PutV2MePassword implements generated.ServerInterface.
+func (s *Server) PutV2MePassword(ctx echo.Context) error {
+	var body generated.ChangePasswordRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.UpdateMyPassword(ctx, generated.UpdateMyPasswordParams{
+		CurrentPassword:      body.CurrentPassword,
+		NewPassword:          body.NewPassword,
+		SignOutOtherSessions: body.SignOutOtherSessions,
+	})
+}
+
+// PostV2MeTotp implements generated.ServerInterface.
+func (s *Server) PostV2MeTotp(ctx echo.Context) error {
+	return s.PostMyTotp(ctx)
+}
+
+// PostV2MeTotpVerify implements generated.ServerInterface.
+func (s *Server) PostV2MeTotpVerify(ctx echo.Context) error {
+	var body generated.CodeRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.PostMyTotpVerify(ctx, generated.PostMyTotpVerifyParams{Code: body.Code})
+}
+
+// PostV2MeTotpDisable implements generated.ServerInterface.
+func (s *Server) PostV2MeTotpDisable(ctx echo.Context) error {
+	var body generated.CodeRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.DeleteMyTotp(ctx, generated.DeleteMyTotpParams{Code: body.Code})
+}
+
+// PostV2PasswordForgot implements generated.ServerInterface.
+func (s *Server) PostV2PasswordForgot(ctx echo.Context) error {
+	var body generated.PhoneNumberRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+
+	if err := s.forgotPassword(ctx, body.PhoneNumber); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, generated.Response{
+		Message: message(ctx, msgResetCodeSent),
+	})
+}
+
+// PostV2PasswordReset implements generated.ServerInterface.
+func (s *Server) PostV2PasswordReset(ctx echo.Context) error {
+	var body generated.PasswordResetRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.PostPasswordReset(ctx, generated.PostPasswordResetParams{
+		PhoneNumber: body.PhoneNumber,
+		Code:        body.Code,
+		NewPassword: body.NewPassword,
+	})
+}
+
+// PostV2PhoneVerification implements generated.ServerInterface.
+func (s *Server) PostV2PhoneVerification(ctx echo.Context) error {
+	var body generated.PhoneNumberRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+
+	if err := s.requestPhoneVerification(ctx, body.PhoneNumber); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, generated.Response{
+		Message: message(ctx, msgVerificationCodeSent),
+	})
+}
+
+// PostV2PhoneVerificationConfirm implements generated.ServerInterface.
+func (s *Server) PostV2PhoneVerificationConfirm(ctx echo.Context) error {
+	var body generated.PhoneCodeRequest
+	if err := bindBody(ctx, &body); err != nil {
+		return err
+	}
+	return s.PostPhoneVerificationConfirm(ctx, generated.PostPhoneVerificationConfirmParams{
+		PhoneNumber: body.PhoneNumber,
+		Code:        body.Code,
+	})
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/otp"
+	"github.com/SawitProRecruitment/UserService/passwordpolicy"
+	"github.com/SawitProRecruitment/UserService/ratelimit"
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_V2(t *testing.T) {
+	type wantS struct {
+		code int
+		body string
+	}
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	keySet := newTestKeySet(t)
+	currentHash, err := testPasswordHashing.Hash("aaaaA1&")
+	if err != nil {
+		t.Fatalf("Hash() err = %v", err)
+	}
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() err = %v", err)
+	}
+
+	accessClaims := &Claims{}
+	accessClaims.Subject = "1"
+	accessClaims.ExpiresAt = time.Now().Add(time.Minute).Unix()
+	accessToken := signTestToken(t, keySet, accessClaims)
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		contentType   string
+		body          string
+		authorization string
+		mockFunc      func()
+		want          wantS
+	}{
+		{
+			name:        "signup",
+			method:      http.MethodPost,
+			path:        "/v2/signup",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"0888-732-928","full_name":"aaa","password":"aabaA1&"}`,
+			mockFunc: func() {
+				mockRepo.EXPECT().SignUp(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input repository.UserInput) (repository.QueryOutput, error) {
+					assert.Equal(t, "+62888732928", input.PhoneNumber)
+					assert.Equal(t, "aaa", input.FullName)
+					return repository.QueryOutput{ID: 7}, nil
+				})
+				mockRepo.EXPECT().GetOTP(gomock.Any(), 7, otpPurposeVerifyPhone).Return(repository.OTPOutput{}, sql.ErrNoRows)
+				mockRepo.EXPECT().SaveOTP(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: wantS{
+				code: http.StatusCreated,
+				body: `{"id":7,"message":"Successfully signed up"}`,
+			},
+		},
+		{
+			name:        "signup with taken phone number",
+			method:      http.MethodPost,
+			path:        "/v2/signup",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"+62888732928","full_name":"aaa","password":"aabaA1&"}`,
+			mockFunc: func() {
+				mockRepo.EXPECT().SignUp(gomock.Any(), gomock.Any()).Return(repository.QueryOutput{}, errors.New("duplicate key"))
+			},
+			want: wantS{
+				code: http.StatusConflict,
+				body: `{"code":"account_exists","instance":"/v2/signup","status":409,"title":"Account already exists","type":"urn:problem-type:user-service:account_exists"}`,
+			},
+		},
+		{
+			name:        "signup with invalid fields",
+			method:      http.MethodPost,
+			path:        "/v2/signup",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"+62888732928","full_name":"aaa","password":"a"}`,
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"validation_failed","detail":"Password must be at least 6 characters, Password must contain an uppercase letter, Password must contain a number, Password must contain a special character","instance":"/v2/signup","status":400,"title":"Request data is not valid","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"password_too_short","field":"password","message":"Password must be at least 6 characters"},{"code":"password_missing_uppercase","field":"password","message":"Password must contain an uppercase letter"},{"code":"password_missing_digit","field":"password","message":"Password must contain a number"},{"code":"password_missing_symbol","field":"password","message":"Password must contain a special character"}]}`,
+			},
+		},
+		{
+			name:        "login",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"+62888732928","password":"aaaaA1&"}`,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), repository.UserInput{PhoneNumber: "+62888732928"}).Return(repository.QueryOutput{ID: 1, Password: currentHash}, nil)
+				mockRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().Logged(gomock.Any(), 1).Return(nil)
+			},
+			want: wantS{
+				code: http.StatusOK,
+			},
+		},
+		{
+			name:        "login with wrong password",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"+62888732928","password":"aabaA1&"}`,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), gomock.Any()).Return(repository.QueryOutput{ID: 1, Password: currentHash}, nil)
+				mockRepo.EXPECT().RecordFailedLogin(gomock.Any(), 1, gomock.Any()).Return(1, nil)
+			},
+			want: wantS{
+				code: http.StatusUnauthorized,
+				body: `{"code":"invalid_credentials","instance":"/v2/login","status":401,"title":"Invalid phone number or password","type":"urn:problem-type:user-service:invalid_credentials"}`,
+			},
+		},
+		{
+			name:   "login with query string",
+			method: http.MethodPost,
+			path:   "/v2/login?phone_number=%2B62888732928&password=aaaaA1%26",
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"validation_failed","detail":"Value is required","instance":"/v2/login","status":400,"title":"Request data is not valid","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"missing_value","field":"body","message":"Value is required"}]}`,
+			},
+		},
+		{
+			name:        "login with malformed body",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":`,
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"malformed_body","instance":"/v2/login","status":400,"title":"Request body is not valid JSON","type":"urn:problem-type:user-service:malformed_body"}`,
+			},
+		},
+		{
+			name:        "login with form body",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: "text/plain",
+			body:        `phone_number=+62888732928`,
+			want: wantS{
+				code: http.StatusUnsupportedMediaType,
+				body: `{"code":"unsupported_media_type","instance":"/v2/login","status":415,"title":"Request body must be JSON","type":"urn:problem-type:user-service:unsupported_media_type"}`,
+			},
+		},
+		{
+			name:        "forgot password",
+			method:      http.MethodPost,
+			path:        "/v2/password/forgot",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"+62888732928"}`,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), gomock.Any()).Return(repository.QueryOutput{}, sql.ErrNoRows)
+			},
+			want: wantS{
+				code: http.StatusAccepted,
+				body: `{"message":"Reset code sent if the phone number belongs to an account"}`,
+			},
+		},
+		{
+			name:   "profile without token",
+			method: http.MethodGet,
+			path:   "/v2/me",
+			want: wantS{
+				code: http.StatusUnauthorized,
+				body: `{"code":"authorization_missing","instance":"/v2/me","status":401,"title":"Authorization header not found","type":"urn:problem-type:user-service:authorization_missing"}`,
+			},
+		},
+		{
+			name:          "profile",
+			method:        http.MethodGet,
+			path:          "/v2/me",
+			authorization: "Bearer " + accessToken,
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(repository.QueryOutput{ID: 1, Name: "aaa", PhoneNumber: "+62888732928"}, nil)
+			},
+			want: wantS{
+				code: http.StatusOK,
+				body: `{"name":"aaa","phone_number":"+62888732928"}`,
+			},
+		},
+		{
+			name:          "update profile",
+			method:        http.MethodPatch,
+			path:          "/v2/me",
+			contentType:   echo.MIMEApplicationJSON,
+			body:          `{"full_name":"bbb"}`,
+			authorization: "Bearer " + accessToken,
+			mockFunc: func() {
+				mockRepo.EXPECT().UpdateUserByID(gomock.Any(), 1, repository.UserInput{FullName: "bbb"}).Return(nil)
+			},
+			want: wantS{
+				code: http.StatusOK,
+				body: `{"message":"Successfully updated user data"}`,
+			},
+		},
+		{
+			name:   "v1 next to v2",
+			method: http.MethodPost,
+			path:   "/login?phone_number=%2B62888732928&password=aabaA1%26",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetUserData(gomock.Any(), gomock.Any()).Return(repository.QueryOutput{}, sql.ErrNoRows)
+			},
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"invalid_credentials","instance":"/login","status":400,"title":"Invalid phone number or password","type":"urn:problem-type:user-service:invalid_credentials"}`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Server{
+				Repository:      mockRepo,
+				Revocations:     repository.NewMemoryRevocationStore(),
+				Keys:            keySet,
+				AccessTokenTTL:  time.Minute,
+				RefreshTokenTTL: time.Hour,
+				Lockout:         DefaultLockoutPolicy,
+				SMS:             &testSMSSender{},
+				OTP:             otp.DefaultPolicy,
+				PhoneRegion:     "ID",
+				PasswordHashing: testPasswordHashing,
+				PasswordPolicy:  passwordpolicy.DefaultPolicy,
+			}
+			e := echo.New()
+			e.HTTPErrorHandler = s.HTTPErrorHandler
+			generated.RegisterHandlers(e.Group("", s.AuthMiddleware(swagger), s.ValidationMiddleware(swagger, ValidationOptions{Responses: true})), s)
+
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			if test.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, test.contentType)
+			}
+			if test.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, test.authorization)
+			}
+			rec := httptest.NewRecorder()
+			if test.mockFunc != nil {
+				test.mockFunc()
+			}
+
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, test.want.code, rec.Code)
+			if test.want.body != "" {
+				assert.Equal(t, test.want.body, strings.TrimSpace(rec.Body.String()))
+			}
+		})
+	}
+}
+
+func Test_RateLimitMiddleware_V2(t *testing.T) {
+	s := &Server{
+		PhoneRegion: "ID",
+		RateLimiter: repository.NewMemoryRateLimitStore(),
+		RateLimits: map[string]ratelimit.Rule{
+			"POST /login": {PerPhone: ratelimit.Limit{Requests: 1, Per: time.Hour}},
+		},
+	}
+	e := echo.New()
+	e.HTTPErrorHandler = s.HTTPErrorHandler
+	handler := func(ctx echo.Context) error {
+		var body generated.LoginRequest
+		if err := bindBody(ctx, &body); err != nil {
+			return err
+		}
+		return ctx.String(http.StatusOK, body.PhoneNumber)
+	}
+	e.POST("/login", handler, s.RateLimitMiddleware())
+	e.POST("/v2/login", handler, s.RateLimitMiddleware())
+
+	// The body is still readable by the handler after the middleware read
+	// the phone number from it.
+	req := httptest.NewRequest(http.MethodPost, "/v2/login", strings.NewReader(`{"phone_number":"0888732928"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "0888732928", rec.Body.String())
+
+	// v1 shares the bucket of the phone number.
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/login?phone_number=%2B62888732928", nil))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
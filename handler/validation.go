@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/labstack/echo/v4"
+)
+
+// Codes of the violations of api.yml found by ValidationMiddleware.
+const (
+	codeMissingValue = "missing_value"
+	codeInvalidValue = "invalid_value"
+)
+
+// bodyField names the violations of a request body as a whole.
+const bodyField = "body"
+
+// ValidationOptions selects what ValidationMiddleware checks.
+type ValidationOptions struct {
+	// Responses also validates the responses of the handlers, which are then
+	// buffered. It is meant for development and tests, so that drift between
+	// the handlers and api.yml fails loudly.
+	Responses bool
+}
+
+// ValidationMiddleware validates every request against the operation that
+// api.yml declares for its route before the handler runs. Requests that
+// break the spec get a validation_failed problem listing each broken field.
+// Security requirements are left to AuthMiddleware.
+func (s *Server) ValidationMiddleware(swagger *openapi3.T, opts ValidationOptions) echo.MiddlewareFunc {
+	routes := make(map[string]*routers.Route)
+	for path, item := range swagger.Paths {
+		route := pathParam.ReplaceAllString(path, ":$1")
+		for method, operation := range item.Operations() {
+			routes[method+" "+route] = &routers.Route{
+				Spec:      swagger,
+				Path:      path,
+				PathItem:  item,
+				Method:    method,
+				Operation: operation,
+			}
+		}
+	}
+	filterOptions := &openapi3filter.Options{
+		MultiError:            true,
+		IncludeResponseStatus: true,
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			route, ok := routes[ctx.Request().Method+" "+ctx.Path()]
+			if !ok {
+				return next(ctx)
+			}
+
+			pathParams := make(map[string]string)
+			for i, name := range ctx.ParamNames() {
+				pathParams[name] = ctx.ParamValues()[i]
+			}
+			input := &openapi3filter.RequestValidationInput{
+				Request:    ctx.Request(),
+				PathParams: pathParams,
+				Route:      route,
+				Options:    filterOptions,
+			}
+			if err := openapi3filter.ValidateRequest(ctx.Request().Context(), input); err != nil {
+				return requestValidationError(err)
+			}
+
+			if !opts.Responses {
+				return next(ctx)
+			}
+			return s.validateResponse(ctx, next, input)
+		}
+	}
+}
+
+// requestValidationError turns the errors of openapi3filter.ValidateRequest
+// into a problem.
+func requestValidationError(err error) error {
+	var violations []violation
+	for _, err := range unwrapMultiError(err) {
+		var requestErr *openapi3filter.RequestError
+		if !errors.As(err, &requestErr) {
+			log.Println("unexpected request validation error:", err)
+			return newError(http.StatusBadRequest, codeInvalidRequest)
+		}
+
+		if requestErr.Parameter != nil {
+			code := codeInvalidValue
+			if errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired) {
+				code = codeMissingValue
+			}
+			violations = append(violations, violation{Field: requestErr.Parameter.Name, Code: code})
+			continue
+		}
+
+		var parseErr *openapi3filter.ParseError
+		switch {
+		case strings.HasPrefix(requestErr.Reason, "header Content-Type has unexpected value"):
+			return newError(http.StatusUnsupportedMediaType, codeUnsupportedMediaType)
+		case errors.As(requestErr.Err, &parseErr):
+			return newError(http.StatusBadRequest, codeMalformedBody)
+		case errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired):
+			violations = append(violations, violation{Field: bodyField, Code: codeMissingValue})
+		default:
+			violations = append(violations, schemaViolations(requestErr.Err)...)
+		}
+	}
+	return validationError(violations)
+}
+
+// schemaViolations lists the fields of a request body that do not match its
+// schema. Nested fields are named by their path, such as "a.b".
+func schemaViolations(err error) []violation {
+	var violations []violation
+	seen := make(map[string]bool)
+	for _, err := range unwrapMultiError(err) {
+		v := violation{Field: bodyField, Code: codeInvalidValue}
+		var schemaErr *openapi3.SchemaError
+		if errors.As(err, &schemaErr) {
+			if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+				v.Field = strings.Join(pointer, ".")
+			}
+			if schemaErr.SchemaField == "required" {
+				v.Code = codeMissingValue
+			}
+		}
+		if !seen[v.Field] {
+			seen[v.Field] = true
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
+// unwrapMultiError flattens the openapi3.MultiError returned when
+// validation collects every error.
+func unwrapMultiError(err error) []error {
+	multi, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+	var errs []error
+	for _, err := range multi {
+		errs = append(errs, unwrapMultiError(err)...)
+	}
+	return errs
+}
+
+// validateResponse runs next with its response buffered, including the
+// problems of its errors, and only sends it once it matches api.yml. A
+// response that drifted from the spec is logged and replaced with a 500.
+func (s *Server) validateResponse(ctx echo.Context, next echo.HandlerFunc, input *openapi3filter.RequestValidationInput) error {
+	original := ctx.Response().Writer
+	buffer := &responseBuffer{header: original.Header().Clone(), status: http.StatusOK}
+	ctx.Response().Writer = buffer
+	if err := next(ctx); err != nil {
+		ctx.Error(err)
+	}
+	ctx.Response().Writer = original
+
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 buffer.status,
+		Header:                 buffer.header,
+		Options:                input.Options,
+	}
+	responseInput.SetBodyBytes(buffer.body.Bytes())
+	if err := openapi3filter.ValidateResponse(ctx.Request().Context(), responseInput); err != nil {
+		log.Printf("response of %s %s does not match api.yml: %v", input.Route.Method, input.Route.Path, err)
+		ctx.SetResponse(echo.NewResponse(original, ctx.Echo()))
+		s.HTTPErrorHandler(internalError(msgResponseInvalid), ctx)
+		return nil
+	}
+
+	for name, values := range buffer.header {
+		original.Header()[name] = values
+	}
+	original.WriteHeader(buffer.status)
+	_, err := original.Write(buffer.body.Bytes())
+	return err
+}
+
+// responseBuffer is an http.ResponseWriter that keeps the response in
+// memory until it is validated.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *responseBuffer) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
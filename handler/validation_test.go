@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidationMiddleware_Request(t *testing.T) {
+	type wantS struct {
+		code int
+		body string
+	}
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() err = %v", err)
+	}
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		want        wantS
+	}{
+		{
+			name:   "valid query",
+			method: http.MethodPost,
+			path:   "/login?phone_number=%2B62888732928&password=aaaaA1%26",
+			want: wantS{
+				code: http.StatusNoContent,
+			},
+		},
+		{
+			name:   "missing query parameter",
+			method: http.MethodPost,
+			path:   "/login?phone_number=%2B62888732928",
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"validation_failed","detail":"Value is required","instance":"/login","status":400,"title":"Request data is not valid","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"missing_value","field":"password","message":"Value is required"}]}`,
+			},
+		},
+		{
+			name:   "value outside enum",
+			method: http.MethodPatch,
+			path:   "/update-my-profile?language=fr",
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"validation_failed","detail":"Value does not match the API specification","instance":"/update-my-profile","status":400,"title":"Request data is not valid","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"invalid_value","field":"language","message":"Value does not match the API specification"}]}`,
+			},
+		},
+		{
+			name:        "valid body",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":"+62888732928","password":"aaaaA1&"}`,
+			want: wantS{
+				code: http.StatusNoContent,
+			},
+		},
+		{
+			name:        "body fields of the wrong type or missing",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":628887}`,
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"validation_failed","detail":"Value does not match the API specification, Value is required","instance":"/v2/login","status":400,"title":"Request data is not valid","type":"urn:problem-type:user-service:validation_failed","violations":[{"code":"invalid_value","field":"phone_number","message":"Value does not match the API specification"},{"code":"missing_value","field":"password","message":"Value is required"}]}`,
+			},
+		},
+		{
+			name:        "malformed body",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"phone_number":`,
+			want: wantS{
+				code: http.StatusBadRequest,
+				body: `{"code":"malformed_body","instance":"/v2/login","status":400,"title":"Request body is not valid JSON","type":"urn:problem-type:user-service:malformed_body"}`,
+			},
+		},
+		{
+			name:        "unsupported media type",
+			method:      http.MethodPost,
+			path:        "/v2/login",
+			contentType: echo.MIMETextPlain,
+			body:        `phone_number=+62888732928`,
+			want: wantS{
+				code: http.StatusUnsupportedMediaType,
+				body: `{"code":"unsupported_media_type","instance":"/v2/login","status":415,"title":"Request body must be JSON","type":"urn:problem-type:user-service:unsupported_media_type"}`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Server{}
+			e := echo.New()
+			e.HTTPErrorHandler = s.HTTPErrorHandler
+			g := e.Group("", s.ValidationMiddleware(swagger, ValidationOptions{}))
+			g.Add(test.method, strings.Split(test.path, "?")[0], func(ctx echo.Context) error {
+				return ctx.NoContent(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			if test.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, test.contentType)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.want.code, rec.Code)
+			assert.Equal(t, test.want.body, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func Test_ValidationMiddleware_Response(t *testing.T) {
+	type wantS struct {
+		code int
+		body string
+	}
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() err = %v", err)
+	}
+	tests := []struct {
+		name    string
+		handler echo.HandlerFunc
+		want    wantS
+	}{
+		{
+			name: "matching response",
+			handler: func(ctx echo.Context) error {
+				return ctx.JSON(http.StatusOK, generated.Profile{Name: "aaa", PhoneNumber: "+62888732928"})
+			},
+			want: wantS{
+				code: http.StatusOK,
+				body: `{"name":"aaa","phone_number":"+62888732928"}`,
+			},
+		},
+		{
+			name: "problem",
+			handler: func(ctx echo.Context) error {
+				return newError(http.StatusNotFound, codeProfileNotFound)
+			},
+			want: wantS{
+				code: http.StatusNotFound,
+				body: `{"code":"profile_not_found","instance":"/my-profile","status":404,"title":"Profile not found","type":"urn:problem-type:user-service:profile_not_found"}`,
+			},
+		},
+		{
+			name: "drifted response",
+			handler: func(ctx echo.Context) error {
+				return ctx.JSON(http.StatusOK, map[string]interface{}{"name": 1})
+			},
+			want: wantS{
+				code: http.StatusInternalServerError,
+				body: `{"code":"internal_error","detail":"Response does not match the API specification","instance":"/my-profile","status":500,"title":"Internal server error","type":"urn:problem-type:user-service:internal_error"}`,
+			},
+		},
+		{
+			name: "response without its declared body",
+			handler: func(ctx echo.Context) error {
+				return ctx.NoContent(http.StatusTeapot)
+			},
+			want: wantS{
+				code: http.StatusInternalServerError,
+				body: `{"code":"internal_error","detail":"Response does not match the API specification","instance":"/my-profile","status":500,"title":"Internal server error","type":"urn:problem-type:user-service:internal_error"}`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Server{}
+			e := echo.New()
+			e.HTTPErrorHandler = s.HTTPErrorHandler
+			e.Group("", s.ValidationMiddleware(swagger, ValidationOptions{Responses: true})).GET("/my-profile", test.handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/my-profile", nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.want.code, rec.Code)
+			assert.Equal(t, test.want.body, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Package health tells an orchestrator whether the service is alive and
+// whether it is ready to serve. Liveness only says that the process
+// answers. Readiness runs the checks registered by what the service depends
+// on, such as the database, so that traffic is only sent to instances that
+// can handle it.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Checker checks one dependency of the service. It returns an error when
+// the service cannot serve because of it.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc is a Checker written as a function.
+type CheckerFunc func(ctx context.Context) error
+
+// Check implements Checker.
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Status tells whether a check, or every check of a report, passes.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusFailing Status = "failing"
+)
+
+// Result is the outcome of one check. Error is only kept for callers of
+// Registry.Check; Handler logs it instead of answering it.
+type Result struct {
+	Status   Status `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report is the outcome of every check. It is failing when any check is.
+type Report struct {
+	Status Status            `json:"status"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+// Registry holds the checks of readiness. It is safe for concurrent use.
+type Registry struct {
+	timeout time.Duration
+
+	mu       sync.RWMutex
+	checkers map[string]Checker
+}
+
+// NewRegistry returns an empty registry whose checks each get timeout to
+// answer.
+func NewRegistry(timeout time.Duration) *Registry {
+	return &Registry{timeout: timeout, checkers: make(map[string]Checker)}
+}
+
+// Register adds a check under name, replacing any check of the same name.
+func (r *Registry) Register(name string, checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers[name] = checker
+}
+
+// Check runs every check concurrently.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	checkers := make(map[string]Checker, len(r.checkers))
+	for name, checker := range r.checkers {
+		checkers[name] = checker
+	}
+	r.mu.RUnlock()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(checkers))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker Checker) {
+			defer wg.Done()
+			result := r.run(ctx, checker)
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if result.Status != StatusOK {
+				report.Status = StatusFailing
+			}
+		}(name, checker)
+	}
+	wg.Wait()
+	return report
+}
+
+func (r *Registry) run(ctx context.Context, checker Checker) Result {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := checker.Check(ctx)
+	result := Result{Status: StatusOK, Duration: time.Since(start).String()}
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		result.Status = StatusFailing
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Handler answers readiness probes with the report of every check, with
+// 200 OK when they all pass and 503 Service Unavailable otherwise. Probes
+// are not authenticated, so the errors of failing checks, which may name
+// hosts or hold driver messages, are logged rather than answered.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report := r.Check(req.Context())
+		status := http.StatusOK
+		if report.Status != StatusOK {
+			status = http.StatusServiceUnavailable
+		}
+		for name, result := range report.Checks {
+			if result.Error != "" {
+				log.Printf("readiness check %s is failing: %s", name, result.Error)
+				result.Error = ""
+				report.Checks[name] = result
+			}
+		}
+		write(w, status, report)
+	})
+}
+
+// LiveHandler answers liveness probes. It checks nothing, so that a
+// failing dependency makes the service unready rather than restarted.
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		write(w, http.StatusOK, Report{Status: StatusOK})
+	})
+}
+
+func write(w http.ResponseWriter, status int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(report)
+}
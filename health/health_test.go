@@ -0,0 +1,99 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Registry_Check(t *testing.T) {
+	tests := []struct {
+		name     string
+		checkers map[string]Checker
+		status   Status
+		errors   map[string]string
+	}{
+		{
+			name:   "no checks",
+			status: StatusOK,
+		},
+		{
+			name: "passing",
+			checkers: map[string]Checker{
+				"database": CheckerFunc(func(ctx context.Context) error { return nil }),
+				"keys":     CheckerFunc(func(ctx context.Context) error { return nil }),
+			},
+			status: StatusOK,
+		},
+		{
+			name: "failing",
+			checkers: map[string]Checker{
+				"database": CheckerFunc(func(ctx context.Context) error { return errors.New("connection refused") }),
+				"keys":     CheckerFunc(func(ctx context.Context) error { return nil }),
+			},
+			status: StatusFailing,
+			errors: map[string]string{"database": "connection refused"},
+		},
+		{
+			name: "timed out",
+			checkers: map[string]Checker{
+				"slow": CheckerFunc(func(ctx context.Context) error {
+					<-ctx.Done()
+					return nil
+				}),
+			},
+			status: StatusFailing,
+			errors: map[string]string{"slow": context.DeadlineExceeded.Error()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewRegistry(10 * time.Millisecond)
+			for name, checker := range test.checkers {
+				r.Register(name, checker)
+			}
+
+			report := r.Check(context.Background())
+			assert.Equal(t, test.status, report.Status)
+			assert.Len(t, report.Checks, len(test.checkers))
+			for name, result := range report.Checks {
+				assert.Equal(t, test.errors[name], result.Error, name)
+				if test.errors[name] != "" {
+					assert.Equal(t, StatusFailing, result.Status, name)
+				}
+			}
+		})
+	}
+}
+
+func Test_Handlers(t *testing.T) {
+	r := NewRegistry(time.Second)
+	failing := errors.New("no signing key")
+	r.Register("keys", CheckerFunc(func(ctx context.Context) error { return failing }))
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var report Report
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, StatusFailing, report.Status)
+	assert.Equal(t, StatusFailing, report.Checks["keys"].Status)
+	assert.NotContains(t, rec.Body.String(), "no signing key")
+
+	failing = nil
+	rec = httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	LiveHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
@@ -0,0 +1,113 @@
+// Package i18n picks the language of user-facing messages and looks them up
+// in a catalog of translations.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language is a supported language, named by its ISO 639-1 code.
+type Language string
+
+const (
+	English    Language = "en"
+	Indonesian Language = "id"
+)
+
+// Default is the language of clients that asked for none of the supported
+// ones.
+const Default = English
+
+// Languages lists every supported language. A catalog must translate each
+// message into all of them.
+var Languages = []Language{English, Indonesian}
+
+// Parse returns the supported language of a language tag such as "id",
+// "id-ID" or "en_US". Regional variants share the messages of their
+// language.
+func Parse(tag string) (Language, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	// "in" is the code Indonesian had before ISO 639 changed it, and is
+	// still sent by older Android versions.
+	if tag == "in" {
+		tag = string(Indonesian)
+	}
+	for _, lang := range Languages {
+		if tag == string(lang) {
+			return lang, true
+		}
+	}
+	return "", false
+}
+
+// Negotiate returns the supported language the client prefers the most in
+// an Accept-Language header. It returns false when the header names none of
+// them.
+func Negotiate(acceptLanguage string) (Language, bool) {
+	var best Language
+	bestQ := 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, q := part, 1.0
+		if i := strings.IndexByte(part, ';'); i >= 0 {
+			tag = part[:i]
+			param := strings.TrimSpace(part[i+1:])
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			var err error
+			if q, err = strconv.ParseFloat(param[2:], 64); err != nil {
+				continue
+			}
+		}
+		lang, ok := Parse(tag)
+		// Earlier languages win ties, as they are listed by preference.
+		if ok && q > bestQ {
+			best, bestQ = lang, q
+		}
+	}
+	return best, bestQ > 0
+}
+
+// Catalog maps the key of each message to its translations. Messages are
+// fmt format strings.
+type Catalog map[string]map[Language]string
+
+// Text returns the message of key in lang, formatted with args. Messages
+// missing in lang fall back to Default, and unknown keys are returned as
+// they are.
+func (c Catalog) Text(lang Language, key string, args ...interface{}) string {
+	translations, ok := c[key]
+	if !ok {
+		return key
+	}
+	text, ok := translations[lang]
+	if !ok {
+		text = translations[Default]
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// Missing returns, in order, the keys that lack a translation into one of
+// the supported languages.
+func (c Catalog) Missing() []string {
+	var missing []string
+	for key, translations := range c {
+		for _, lang := range Languages {
+			if translations[lang] == "" {
+				missing = append(missing, key)
+				break
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
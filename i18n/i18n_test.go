@@ -0,0 +1,69 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want Language
+		ok   bool
+	}{
+		{tag: "en", want: English, ok: true},
+		{tag: "id", want: Indonesian, ok: true},
+		{tag: "id-ID", want: Indonesian, ok: true},
+		{tag: "EN_us", want: English, ok: true},
+		{tag: "in", want: Indonesian, ok: true},
+		{tag: " id ", want: Indonesian, ok: true},
+		{tag: "ms", ok: false},
+		{tag: "", ok: false},
+		{tag: "*", ok: false},
+	}
+	for _, test := range tests {
+		got, ok := Parse(test.tag)
+		assert.Equal(t, test.ok, ok, test.tag)
+		assert.Equal(t, test.want, got, test.tag)
+	}
+}
+
+func Test_Negotiate(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Language
+		ok     bool
+	}{
+		{header: "id-ID,id;q=0.9,en-US;q=0.8,en;q=0.7", want: Indonesian, ok: true},
+		{header: "en-US,en;q=0.9", want: English, ok: true},
+		{header: "fr-FR, id;q=0.5, en;q=0.4", want: Indonesian, ok: true},
+		{header: "en;q=0.4, id;q=0.8", want: Indonesian, ok: true},
+		{header: "id, en", want: Indonesian, ok: true},
+		{header: "id;q=0, en;q=0.1", want: English, ok: true},
+		{header: "id;q=abc, en;q=0.1", want: English, ok: true},
+		{header: "fr, de", ok: false},
+		{header: "*", ok: false},
+		{header: "", ok: false},
+	}
+	for _, test := range tests {
+		got, ok := Negotiate(test.header)
+		assert.Equal(t, test.ok, ok, test.header)
+		assert.Equal(t, test.want, got, test.header)
+	}
+}
+
+func Test_Catalog(t *testing.T) {
+	c := Catalog{
+		"hello":   {English: "Hello", Indonesian: "Halo"},
+		"minimum": {English: "At least %d", Indonesian: "Minimal %d"},
+		"partial": {English: "Only English"},
+	}
+
+	assert.Equal(t, "Halo", c.Text(Indonesian, "hello"))
+	assert.Equal(t, "Hello", c.Text(English, "hello"))
+	assert.Equal(t, "Minimal 6", c.Text(Indonesian, "minimum", 6))
+	assert.Equal(t, "Only English", c.Text(Indonesian, "partial"))
+	assert.Equal(t, "unknown", c.Text(Indonesian, "unknown"))
+	assert.Equal(t, []string{"partial"}, c.Missing())
+}
@@ -0,0 +1,56 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+)
+
+// JSONWebKey is the public half of a key as described in RFC 7517.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys of every active and retired key so that other
+// services can verify tokens without sharing a secret.
+func (s *KeySet) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, key := range s.Keys() {
+		jwk := JSONWebKey{
+			KeyID:     key.ID,
+			Use:       "sig",
+			Algorithm: key.Algorithm,
+		}
+		switch pub := key.PublicKey().(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = encode(pub.N.Bytes())
+			jwk.E = encode(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			jwk.KeyType = "EC"
+			jwk.Curve = pub.Curve.Params().Name
+			jwk.X = encode(pub.X.FillBytes(make([]byte, size)))
+			jwk.Y = encode(pub.Y.FillBytes(make([]byte, size)))
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
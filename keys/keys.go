@@ -0,0 +1,272 @@
+// Package keys manages the asymmetric keys used to sign and verify the
+// tokens issued by the service. A KeySet can hold several active and
+// retired keys at once so that keys can be rotated without invalidating
+// tokens that were signed by the previous key.
+package keys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+
+	// minRSABits is the smallest RSA modulus accepted for signing keys.
+	minRSABits = 2048
+
+	// retiredSuffix marks a key file that must no longer sign new tokens
+	// but is still published and accepted for verification.
+	retiredSuffix = ".retired"
+)
+
+var (
+	ErrKeyNotFound      = errors.New("signing key not found")
+	ErrNoSigningKey     = errors.New("no active signing key")
+	ErrUnsupportedKey   = errors.New("unsupported key type")
+	ErrAlgorithmInvalid = errors.New("token algorithm does not match key")
+)
+
+type Status int
+
+const (
+	// StatusActive keys are published and accepted, and may sign tokens.
+	StatusActive Status = iota
+	// StatusRetired keys are published and accepted, but never sign tokens.
+	StatusRetired
+)
+
+type Key struct {
+	ID        string
+	Algorithm string
+	Status    Status
+	private   crypto.Signer
+}
+
+// NewKey wraps a private key, deriving the signing algorithm from its type.
+func NewKey(id string, private crypto.Signer, status Status) (*Key, error) {
+	if id == "" {
+		return nil, errors.New("key id is required")
+	}
+	key := &Key{ID: id, Status: status, private: private}
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("key %s: RSA key must be at least %d bits", id, minRSABits)
+		}
+		key.Algorithm = AlgorithmRS256
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("key %s: ECDSA key must use the P-256 curve", id)
+		}
+		key.Algorithm = AlgorithmES256
+	default:
+		return nil, fmt.Errorf("key %s: %w %T", id, ErrUnsupportedKey, private)
+	}
+	return key, nil
+}
+
+// Generate creates a fresh in-memory key for the given algorithm.
+func Generate(id, algorithm string) (*Key, error) {
+	var private crypto.Signer
+	var err error
+	switch algorithm {
+	case AlgorithmRS256:
+		private, err = rsa.GenerateKey(rand.Reader, minRSABits)
+	case AlgorithmES256:
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKey, algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(id, private, StatusActive)
+}
+
+// PublicKey returns the verification half of the key.
+func (k *Key) PublicKey() crypto.PublicKey {
+	return k.private.Public()
+}
+
+func (k *Key) signingMethod() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmES256 {
+		return jwt.SigningMethodES256
+	}
+	return jwt.SigningMethodRS256
+}
+
+type KeySet struct {
+	keys      map[string]*Key
+	signingID string
+}
+
+// NewKeySet builds a key set from the given keys. signingID selects the key
+// used for new tokens; when empty, the active key with the greatest ID is
+// used, so date-based IDs such as "2024-01" rotate naturally.
+func NewKeySet(keys []*Key, signingID string) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		if _, exists := set.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate key id %s", key.ID)
+		}
+		set.keys[key.ID] = key
+		if signingID == "" && key.Status == StatusActive && key.ID > set.signingID {
+			set.signingID = key.ID
+		}
+	}
+	if signingID != "" {
+		key, ok := set.keys[signingID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, signingID)
+		}
+		if key.Status != StatusActive {
+			return nil, fmt.Errorf("key %s is retired and cannot sign", signingID)
+		}
+		set.signingID = signingID
+	}
+	if set.signingID == "" {
+		return nil, ErrNoSigningKey
+	}
+	return set, nil
+}
+
+// LoadDir reads every PEM encoded private key in dir. The file name without
+// extension is used as the key ID, and files named "<id>.retired.pem" are
+// loaded as retired keys.
+func LoadDir(dir, signingID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	var loaded []*Key
+	for _, path := range paths {
+		id := strings.TrimSuffix(filepath.Base(path), ".pem")
+		status := StatusActive
+		if strings.HasSuffix(id, retiredSuffix) {
+			id = strings.TrimSuffix(id, retiredSuffix)
+			status = StatusRetired
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		private, err := ParsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", path, err)
+		}
+		key, err := NewKey(id, private, status)
+		if err != nil {
+			return nil, err
+		}
+		loaded = append(loaded, key)
+	}
+	return NewKeySet(loaded, signingID)
+}
+
+// ParsePrivateKey decodes a PKCS#8, PKCS#1 or SEC 1 PEM block.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var private interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: PEM block %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w %T", ErrUnsupportedKey, private)
+	}
+	return signer, nil
+}
+
+// SigningKey returns the key that signs new tokens.
+func (s *KeySet) SigningKey() *Key {
+	return s.keys[s.signingID]
+}
+
+// Keys returns every key in the set ordered by ID.
+func (s *KeySet) Keys() []*Key {
+	list := make([]*Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		list = append(list, key)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// Algorithms returns the signing algorithms accepted by the set.
+func (s *KeySet) Algorithms() []string {
+	return []string{AlgorithmRS256, AlgorithmES256}
+}
+
+// Sign signs the claims with the current signing key and stamps its ID in
+// the "kid" header.
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key := s.SigningKey()
+	token := jwt.NewWithClaims(key.signingMethod(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.private)
+}
+
+// Check signs a token with the signing key and verifies it, which fails
+// when the key cannot sign.
+func (s *KeySet) Check() error {
+	if s == nil || s.SigningKey() == nil {
+		return ErrNoSigningKey
+	}
+	token, err := s.Sign(jwt.StandardClaims{Subject: "health"})
+	if err != nil {
+		return err
+	}
+	_, err = s.Parse(token, &jwt.StandardClaims{})
+	return err
+}
+
+// Parse verifies tokenString against the key named by its "kid" header and
+// decodes it into claims.
+func (s *KeySet) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	parser := jwt.Parser{ValidMethods: s.Algorithms()}
+	return parser.ParseWithClaims(tokenString, claims, s.keyfunc)
+}
+
+func (s *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	id, _ := token.Header["kid"].(string)
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrKeyNotFound, id)
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrAlgorithmInvalid
+	}
+	return key.PublicKey(), nil
+}
@@ -0,0 +1,130 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeECKey(t *testing.T, dir, name string) {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() err = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() err = %v", err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+		t.Fatalf("WriteFile() err = %v", err)
+	}
+}
+
+func Test_LoadDir(t *testing.T) {
+	dir := t.TempDir()
+	writeECKey(t, dir, "2024-01.retired.pem")
+	writeECKey(t, dir, "2024-02.pem")
+	writeECKey(t, dir, "2024-03.pem")
+
+	tests := []struct {
+		name      string
+		signingID string
+		wantID    string
+		wantErr   bool
+	}{
+		{
+			name:   "newest active key signs by default",
+			wantID: "2024-03",
+		},
+		{
+			name:      "explicit signing key",
+			signingID: "2024-02",
+			wantID:    "2024-02",
+		},
+		{
+			name:      "retired key cannot sign",
+			signingID: "2024-01",
+			wantErr:   true,
+		},
+		{
+			name:      "unknown key",
+			signingID: "2023-12",
+			wantErr:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keySet, err := LoadDir(dir, test.signingID)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantID, keySet.SigningKey().ID)
+			assert.Len(t, keySet.JWKS().Keys, 3)
+		})
+	}
+}
+
+func Test_KeySet_Rotation(t *testing.T) {
+	oldKey, err := Generate("old", AlgorithmRS256)
+	assert.NoError(t, err)
+	newKey, err := Generate("new", AlgorithmES256)
+	assert.NoError(t, err)
+
+	before, err := NewKeySet([]*Key{oldKey}, "")
+	assert.NoError(t, err)
+	oldToken, err := before.Sign(&jwt.StandardClaims{Subject: "1"})
+	assert.NoError(t, err)
+
+	oldKey.Status = StatusRetired
+	after, err := NewKeySet([]*Key{oldKey, newKey}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", after.SigningKey().ID)
+
+	newToken, err := after.Sign(&jwt.StandardClaims{Subject: "2"})
+	assert.NoError(t, err)
+
+	for _, tokenString := range []string{oldToken, newToken} {
+		token, err := after.Parse(tokenString, &jwt.StandardClaims{})
+		assert.NoError(t, err)
+		assert.True(t, token.Valid)
+	}
+
+	_, err = before.Parse(newToken, &jwt.StandardClaims{})
+	assert.Error(t, err)
+}
+
+func Test_KeySet_RejectsHMAC(t *testing.T) {
+	key, err := Generate("test", AlgorithmES256)
+	assert.NoError(t, err)
+	keySet, err := NewKeySet([]*Key{key}, "")
+	assert.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{})
+	token.Header["kid"] = "test"
+	tokenString, err := token.SignedString([]byte("secret"))
+	assert.NoError(t, err)
+
+	_, err = keySet.Parse(tokenString, &jwt.StandardClaims{})
+	assert.Error(t, err)
+}
+
+func Test_KeySet_Check(t *testing.T) {
+	key, err := Generate("test", AlgorithmES256)
+	assert.NoError(t, err)
+	keySet, err := NewKeySet([]*Key{key}, "")
+	assert.NoError(t, err)
+
+	assert.NoError(t, keySet.Check())
+	assert.ErrorIs(t, (*KeySet)(nil).Check(), ErrNoSigningKey)
+}
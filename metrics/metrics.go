@@ -0,0 +1,182 @@
+// Package metrics exposes the metrics of the service to Prometheus: the
+// requests it serves, the outcomes of authentication, the time spent
+// hashing passwords and querying the database, and the state of the
+// database connection pool. Metrics are registered on Registry rather than
+// on the global registry of the client library, so that only the metrics
+// of the service are served.
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds every metric of the service, along with the metrics of
+// the Go runtime and of the process.
+var Registry = prometheus.NewRegistry()
+
+// Outcomes of a login, the label of Logins.
+const (
+	LoginSucceeded          = "success"
+	LoginMFARequired        = "mfa_required"
+	LoginInvalidCredentials = "invalid_credentials"
+	LoginInvalidMFACode     = "invalid_mfa_code"
+	LoginLocked             = "locked"
+)
+
+// Outcomes of a signup, the label of Signups.
+const (
+	SignupSucceeded = "success"
+	SignupInvalid   = "invalid"
+	SignupExists    = "exists"
+)
+
+// Operations of PasswordHashDuration.
+const (
+	HashOperationHash   = "hash"
+	HashOperationVerify = "verify"
+)
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests served, by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time taken to serve HTTP requests, by method, route and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// Logins counts the attempts to log in by outcome. A login with two
+	// factors counts as mfa_required, then as success or invalid_mfa_code.
+	Logins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_logins_total",
+		Help: "Login attempts, by outcome.",
+	}, []string{"outcome"})
+
+	// Signups counts the attempts to sign up by outcome.
+	Signups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_signups_total",
+		Help: "Signup attempts, by outcome.",
+	}, []string{"outcome"})
+
+	// TokenValidationFailures counts the requests to secured operations
+	// rejected because of their access token, by the code of the problem
+	// answered.
+	TokenValidationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_token_validation_failures_total",
+		Help: "Requests rejected because of their access token, by reason.",
+	}, []string{"reason"})
+
+	// Lockouts counts the accounts locked after too many failed logins.
+	Lockouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "auth_lockouts_total",
+		Help: "Accounts locked after too many failed logins.",
+	})
+
+	// PasswordHashDuration observes the time taken to hash a password or to
+	// verify one against a hash, by algorithm.
+	PasswordHashDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "password_hash_duration_seconds",
+		Help:    "Time taken to hash and verify passwords, by operation and algorithm.",
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "algorithm"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Time taken by the queries of the repository, by method and outcome.",
+		Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"method", "outcome"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		Logins,
+		Signups,
+		TokenValidationFailures,
+		Lockouts,
+		PasswordHashDuration,
+		dbQueryDuration,
+	)
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// RegisterDB exposes the connection pool statistics of db, labelled with
+// name.
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// Middleware counts and times every request by method, route and status
+// code. Routes are the paths registered in echo, such as "/users/:id", so
+// that the number of series stays bounded; requests that match no route
+// are labelled "unmatched". It must come first, so that it sees the status
+// code of requests rejected by other middleware.
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			if err := next(ctx); err != nil {
+				// The error is answered here, rather than after the
+				// middleware returns, to know its status code.
+				ctx.Error(err)
+			}
+
+			route := ctx.Path()
+			if route == "" || ctx.Response().Status == http.StatusNotFound && route == "/*" {
+				route = "unmatched"
+			}
+			labels := prometheus.Labels{
+				"method": ctx.Request().Method,
+				"route":  route,
+				"status": strconv.Itoa(ctx.Response().Status),
+			}
+			httpRequests.With(labels).Inc()
+			httpDuration.With(labels).Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
+
+// ObserveHash records how long a password hash operation took since start.
+func ObserveHash(operation, algorithm string, start time.Time) {
+	if algorithm == "" {
+		algorithm = "unknown"
+	}
+	PasswordHashDuration.WithLabelValues(operation, algorithm).Observe(time.Since(start).Seconds())
+}
+
+// ObserveQuery records how long a method of the repository took since
+// start, and whether it failed. It is meant to be deferred with the named
+// error result of the method:
+//
+//	defer metrics.ObserveQuery("GetUserData", time.Now(), &err)
+func ObserveQuery(method string, start time.Time, err *error) {
+	outcome := "ok"
+	switch {
+	case *err == nil:
+	case errors.Is(*err, sql.ErrNoRows):
+		outcome = "no_rows"
+	default:
+		outcome = "error"
+	}
+	dbQueryDuration.WithLabelValues(method, outcome).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Middleware(t *testing.T) {
+	e := echo.New()
+	e.Use(Middleware())
+	e.GET("/users/:id", func(ctx echo.Context) error {
+		if ctx.Param("id") == "0" {
+			return echo.NewHTTPError(http.StatusBadRequest)
+		}
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	tests := []struct {
+		name   string
+		path   string
+		route  string
+		status string
+	}{
+		{name: "ok", path: "/users/1", route: "/users/:id", status: "200"},
+		{name: "handler error", path: "/users/0", route: "/users/:id", status: "400"},
+		{name: "no route", path: "/nowhere", route: "unmatched", status: "404"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			counter := httpRequests.WithLabelValues(http.MethodGet, test.route, test.status)
+			before := testutil.ToFloat64(counter)
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, test.path, nil))
+			assert.Equal(t, test.status, strconv.Itoa(rec.Code))
+			assert.Equal(t, before+1, testutil.ToFloat64(counter))
+		})
+	}
+}
+
+func Test_ObserveQuery(t *testing.T) {
+	tests := []struct {
+		err     error
+		outcome string
+	}{
+		{err: nil, outcome: "ok"},
+		{err: sql.ErrNoRows, outcome: "no_rows"},
+		{err: errors.New("connection refused"), outcome: "error"},
+	}
+	for _, test := range tests {
+		t.Run(test.outcome, func(t *testing.T) {
+			method := "Test_ObserveQuery_" + test.outcome
+			func() (err error) {
+				defer ObserveQuery(method, time.Now(), &err)
+				return test.err
+			}()
+
+			var m dto.Metric
+			err := dbQueryDuration.WithLabelValues(method, test.outcome).(prometheus.Metric).Write(&m)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+		})
+	}
+}
+
+func Test_Handler(t *testing.T) {
+	db, err := sql.Open("postgres", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	assert.NoError(t, RegisterDB(db, "test"))
+
+	Logins.WithLabelValues(LoginSucceeded).Inc()
+	ObserveHash(HashOperationVerify, "", time.Now())
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	for _, want := range []string{
+		`auth_logins_total{outcome="success"}`,
+		`password_hash_duration_seconds_count{algorithm="unknown",operation="verify"}`,
+		`go_sql_open_connections{db_name="test"}`,
+		"go_goroutines",
+	} {
+		assert.Contains(t, rec.Body.String(), want)
+	}
+}
@@ -0,0 +1,11 @@
+-- The schema as database.sql created it. The statement is guarded, so
+-- databases that were initialized from database.sql are adopted as they are,
+-- and the migrations after this one bring them up to date.
+
+CREATE TABLE IF NOT EXISTS users (
+    id SERIAL PRIMARY KEY,
+    phone_number VARCHAR(13) UNIQUE NOT NULL,
+    full_name VARCHAR(60) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    successful_login INTEGER DEFAULT 0
+);
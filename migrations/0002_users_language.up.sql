@@ -0,0 +1,2 @@
+-- The language users chose for messages. NULL follows Accept-Language.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS language VARCHAR(8);
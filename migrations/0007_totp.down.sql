@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS mfa_recovery_codes;
+ALTER TABLE users DROP COLUMN IF EXISTS totp_last_counter;
+ALTER TABLE users DROP COLUMN IF EXISTS totp_enabled_at;
+ALTER TABLE users DROP COLUMN IF EXISTS totp_secret;
@@ -0,0 +1,16 @@
+-- Verified phone numbers, and the one-time codes texted to prove them, to
+-- change them and to reset passwords.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone_verified_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS otp_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose VARCHAR(32) NOT NULL,
+    phone_number VARCHAR(16) NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    UNIQUE (user_id, purpose)
+);
@@ -0,0 +1,2 @@
+-- Fails while a stored phone number is longer than the former column.
+ALTER TABLE users ALTER COLUMN phone_number TYPE VARCHAR(13);
@@ -0,0 +1,2 @@
+-- Phone numbers in E.164 format take up to 15 digits and the plus sign.
+ALTER TABLE users ALTER COLUMN phone_number TYPE VARCHAR(16);
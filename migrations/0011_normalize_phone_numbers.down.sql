@@ -0,0 +1,3 @@
+-- The rewritten numbers stay in E.164 format, which the former versions
+-- look up as typed.
+DROP TABLE IF EXISTS phone_number_conflicts;
@@ -0,0 +1,26 @@
+-- Phone numbers used to be stored as typed, "+62" and 9 to 12 digits, and
+-- are now stored in E.164 format, which leaves out the trunk prefix that
+-- "+620812..." repeats. Those numbers are rewritten to the "+62812..." they
+-- are now looked up as.
+--
+-- A number whose E.164 form already belongs to another account is the same
+-- phone as that account's. It is left as it is and recorded here, for the
+-- two accounts to be merged or one of them renumbered by hand; until then
+-- it cannot sign in.
+CREATE TABLE IF NOT EXISTS phone_number_conflicts (
+    user_id INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    phone_number VARCHAR(16) NOT NULL,
+    conflicts_with INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE
+);
+
+INSERT INTO phone_number_conflicts (user_id, phone_number, conflicts_with)
+SELECT u.id, u.phone_number, other.id
+FROM users AS u
+JOIN users AS other ON other.phone_number = '+62' || substr(u.phone_number, 5)
+WHERE u.phone_number ~ '^\+620[0-9]{8,11}$'
+ON CONFLICT (user_id) DO NOTHING;
+
+UPDATE users AS u
+SET phone_number = '+62' || substr(u.phone_number, 5)
+WHERE u.phone_number ~ '^\+620[0-9]{8,11}$'
+    AND NOT EXISTS (SELECT 1 FROM phone_number_conflicts AS c WHERE c.user_id = u.id);
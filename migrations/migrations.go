@@ -0,0 +1,331 @@
+// Package migrations evolves the database schema. Migrations are SQL files
+// embedded in the binary, named "<version>_<name>.up.sql" with a matching
+// ".down.sql" that reverts them. Versions are applied in order, each in its
+// own transaction, and recorded in the schema_migrations table with the
+// checksum of their up file, so that a migration edited after it was
+// applied is detected instead of silently diverging.
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed *.sql
+var embedded embed.FS
+
+// lockID is the Postgres advisory lock held while migrating, so that
+// instances starting together do not apply the same migration twice.
+const lockID = 0x75736572 // "user"
+
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one version of the schema.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Applied is a migration recorded in schema_migrations.
+type Applied struct {
+	Version   int64
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// Embedded returns the migrations built into the binary.
+func Embedded() []Migration {
+	migrations, err := Load(embedded)
+	if err != nil {
+		panic(err)
+	}
+	return migrations
+}
+
+// Load reads the migrations in the root of fsys, ordered by version. Every
+// version needs both an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migrations: %s is not named <version>_<name>.up.sql or .down.sql", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("migrations: %s has an invalid version", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migrations: version %d is used by both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+			sum := sha256.Sum256(content)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migrations: version %d (%s) needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// ErrChecksumMismatch is returned when an applied migration no longer
+// matches its file.
+var ErrChecksumMismatch = errors.New("migrations: checksum mismatch")
+
+// ErrUnknownVersion is returned when the database was migrated by a newer
+// binary that knows versions this one does not.
+var ErrUnknownVersion = errors.New("migrations: unknown version")
+
+// ErrPending is returned by Check while migrations are not applied yet.
+var ErrPending = errors.New("migrations: pending")
+
+// verify checks that every applied migration is still the one in
+// migrations.
+func verify(migrations []Migration, applied []Applied) error {
+	known := make(map[int64]Migration, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = m
+	}
+	for _, a := range applied {
+		m, ok := known[a.Version]
+		if !ok {
+			return fmt.Errorf("%w: version %d (%s) is applied but not embedded", ErrUnknownVersion, a.Version, a.Name)
+		}
+		if m.Checksum != a.Checksum {
+			return fmt.Errorf("%w: version %d (%s) changed after it was applied", ErrChecksumMismatch, a.Version, a.Name)
+		}
+	}
+	return nil
+}
+
+// pending returns the migrations that are not applied yet, in order.
+func pending(migrations []Migration, applied []Applied) []Migration {
+	done := make(map[int64]bool, len(applied))
+	for _, a := range applied {
+		done[a.Version] = true
+	}
+	var todo []Migration
+	for _, m := range migrations {
+		if !done[m.Version] {
+			todo = append(todo, m)
+		}
+	}
+	return todo
+}
+
+// latest returns the last steps applied migrations, newest first.
+func latest(migrations []Migration, applied []Applied, steps int) []Migration {
+	known := make(map[int64]Migration, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = m
+	}
+	var todo []Migration
+	for i := len(applied) - 1; i >= 0 && len(todo) < steps; i-- {
+		todo = append(todo, known[applied[i].Version])
+	}
+	return todo
+}
+
+// Migrator applies migrations to a database.
+type Migrator struct {
+	Db         *sql.DB
+	Migrations []Migration
+}
+
+// NewMigrator returns a Migrator of the embedded migrations.
+func NewMigrator(db *sql.DB) *Migrator {
+	return &Migrator{Db: db, Migrations: Embedded()}
+}
+
+// Status returns the applied migrations, in order, and those still
+// pending.
+func (m *Migrator) Status(ctx context.Context) (applied []Applied, todo []Migration, err error) {
+	err = m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err = readApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		todo = pending(m.Migrations, applied)
+		return nil
+	})
+	return
+}
+
+// Up applies every pending migration and returns them.
+func (m *Migrator) Up(ctx context.Context) (done []Migration, err error) {
+	err = m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := readApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := verify(m.Migrations, applied); err != nil {
+			return err
+		}
+		for _, migration := range pending(m.Migrations, applied) {
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)", migration.Version, migration.Name, migration.Checksum)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migrations: apply version %d (%s): %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return
+}
+
+// Down reverts the last steps applied migrations and returns them, newest
+// first.
+func (m *Migrator) Down(ctx context.Context, steps int) (done []Migration, err error) {
+	err = m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := readApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := verify(m.Migrations, applied); err != nil {
+			return err
+		}
+		for _, migration := range latest(m.Migrations, applied, steps) {
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migrations: revert version %d (%s): %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return
+}
+
+// Check reports whether the database is at the schema of the migrations:
+// every migration applied and unchanged. It takes no lock, so that it
+// answers while another instance is migrating.
+func (m *Migrator) Check(ctx context.Context) error {
+	applied, err := readApplied(ctx, m.Db)
+	if err != nil {
+		return err
+	}
+	if err := verify(m.Migrations, applied); err != nil {
+		return err
+	}
+	if todo := pending(m.Migrations, applied); len(todo) > 0 {
+		return fmt.Errorf("%w: %d, from version %d (%s)", ErrPending, len(todo), todo[0].Version, todo[0].Name)
+	}
+	return nil
+}
+
+// locked runs f on a connection holding the migration lock, once
+// schema_migrations exists.
+func (m *Migrator) locked(ctx context.Context, f func(conn *sql.Conn) error) error {
+	conn, err := m.Db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return fmt.Errorf("migrations: lock: %w", err)
+	}
+	// The lock belongs to the session, so it is released even when the
+	// context is already done.
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID)
+
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("migrations: create schema_migrations: %w", err)
+	}
+	return f(conn)
+}
+
+// queryer is a *sql.DB or a *sql.Conn.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func readApplied(ctx context.Context, conn queryer) (applied []Applied, err error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("migrations: read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var a Applied
+		if err := rows.Scan(&a.Version, &a.Name, &a.Checksum, &a.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied = append(applied, a)
+	}
+	return applied, rows.Err()
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, f func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,125 @@
+package migrations
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Load(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		versions []int64
+		err      string
+	}{
+		{
+			name: "ordered by version",
+			files: fstest.MapFS{
+				"10_ten.up.sql":     {Data: []byte("SELECT 10")},
+				"10_ten.down.sql":   {Data: []byte("SELECT -10")},
+				"2_two.up.sql":      {Data: []byte("SELECT 2")},
+				"2_two.down.sql":    {Data: []byte("SELECT -2")},
+				"0001_one.up.sql":   {Data: []byte("SELECT 1")},
+				"0001_one.down.sql": {Data: []byte("SELECT -1")},
+			},
+			versions: []int64{1, 2, 10},
+		},
+		{
+			name: "unexpected file",
+			files: fstest.MapFS{
+				"0001_one.sql": {Data: []byte("SELECT 1")},
+			},
+			err: "migrations: 0001_one.sql is not named <version>_<name>.up.sql or .down.sql",
+		},
+		{
+			name: "missing down",
+			files: fstest.MapFS{
+				"0001_one.up.sql": {Data: []byte("SELECT 1")},
+			},
+			err: "migrations: version 1 (one) needs both an up and a down file",
+		},
+		{
+			name: "version used twice",
+			files: fstest.MapFS{
+				"0001_one.up.sql":   {Data: []byte("SELECT 1")},
+				"0001_other.up.sql": {Data: []byte("SELECT 1")},
+			},
+			err: "migrations: version 1 is used by both one and other",
+		},
+		{
+			name: "version zero",
+			files: fstest.MapFS{
+				"0000_zero.up.sql": {Data: []byte("SELECT 0")},
+			},
+			err: "migrations: 0000_zero.up.sql has an invalid version",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			migrations, err := Load(test.files)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			var versions []int64
+			for _, m := range migrations {
+				versions = append(versions, m.Version)
+				assert.Len(t, m.Checksum, 64)
+			}
+			assert.Equal(t, test.versions, versions)
+		})
+	}
+}
+
+func Test_Embedded(t *testing.T) {
+	migrations := Embedded()
+	assert.NotEmpty(t, migrations)
+	for i, m := range migrations {
+		// Versions are numbered without gaps, so that a missing file shows.
+		assert.Equal(t, int64(i+1), m.Version, m.Name)
+	}
+}
+
+func Test_verify(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "one", Checksum: "a"},
+		{Version: 2, Name: "two", Checksum: "b"},
+	}
+	tests := []struct {
+		name    string
+		applied []Applied
+		err     error
+	}{
+		{name: "nothing applied"},
+		{name: "applied", applied: []Applied{{Version: 1, Name: "one", Checksum: "a"}}},
+		{name: "changed", applied: []Applied{{Version: 1, Name: "one", Checksum: "c"}}, err: ErrChecksumMismatch},
+		{name: "newer database", applied: []Applied{{Version: 3, Name: "three", Checksum: "c"}}, err: ErrUnknownVersion},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verify(migrations, test.applied)
+			if test.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, test.err), err)
+		})
+	}
+}
+
+func Test_pending_latest(t *testing.T) {
+	migrations := []Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+	applied := []Applied{{Version: 1}, {Version: 2}}
+
+	assert.Equal(t, []Migration{{Version: 3}}, pending(migrations, applied))
+	assert.Equal(t, migrations, pending(migrations, nil))
+	assert.Empty(t, pending(migrations, []Applied{{Version: 1}, {Version: 2}, {Version: 3}}))
+
+	assert.Equal(t, []Migration{{Version: 2}}, latest(migrations, applied, 1))
+	assert.Equal(t, []Migration{{Version: 2}, {Version: 1}}, latest(migrations, applied, 5))
+	assert.Empty(t, latest(migrations, nil, 1))
+}
@@ -0,0 +1,116 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDatabase returns the URL of a schema of its own in the Postgres
+// database named by TEST_DATABASE_URL, and skips the test when it is not
+// set.
+func testDatabase(t *testing.T) string {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	admin, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	schema := fmt.Sprintf("migrations_test_%d", time.Now().UnixNano())
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// Connection parameters lib/pq does not know are sent to the server
+	// as settings of the session.
+	if u, err := url.Parse(dsn); err == nil && strings.HasPrefix(u.Scheme, "postgres") {
+		query := u.Query()
+		query.Set("search_path", schema)
+		u.RawQuery = query.Encode()
+		return u.String()
+	}
+	return dsn + " search_path=" + schema
+}
+
+func Test_Up_FromDatabaseSQL(t *testing.T) {
+	dsn := testDatabase(t)
+	ctx := context.Background()
+	repo := repository.NewRepository(repository.NewRepositoryOptions{Dsn: dsn})
+	defer repo.Db.Close()
+
+	// A database initialized from database.sql before migrations existed.
+	baseline, err := os.ReadFile("testdata/database.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Db.Exec(string(baseline)); err != nil {
+		t.Fatal(err)
+	}
+	var id int
+	err = repo.Db.QueryRow("INSERT INTO users (phone_number, full_name, password_hash) VALUES ('+62812734928', 'Budi Santoso', 'hash') RETURNING id").Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Numbers stored with the trunk prefix, one of which collides with the
+	// E.164 form of the user above.
+	_, err = repo.Db.Exec("INSERT INTO users (phone_number, full_name, password_hash) VALUES ('+6208127349281', 'Siti Rahma', 'hash'), ('+620812734928', 'Agus Salim', 'hash')")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrator := NewMigrator(repo.Db)
+	done, err := migrator.Up(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, done, len(migrator.Migrations))
+	assert.NoError(t, migrator.Check(ctx))
+
+	_, err = repo.GetUserData(ctx, repository.UserInput{PhoneNumber: "+628127349281"})
+	assert.NoError(t, err)
+	// The colliding number is left for repair by hand.
+	var conflictsWith int
+	err = repo.Db.QueryRow("SELECT c.conflicts_with FROM phone_number_conflicts AS c JOIN users AS u ON u.id = c.user_id WHERE u.phone_number = '+620812734928'").Scan(&conflictsWith)
+	assert.NoError(t, err)
+	assert.Equal(t, id, conflictsWith)
+
+	// The queries of the repository find every column they use.
+	user, err := repo.GetUserData(ctx, repository.UserInput{PhoneNumber: "+62812734928"})
+	assert.NoError(t, err)
+	assert.Equal(t, id, user.ID)
+	_, err = repo.RecordFailedLogin(ctx, id, time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	_, err = repo.GetTOTP(ctx, id)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.UpdatePassword(ctx, id, []byte("new hash")))
+	history, err := repo.GetPasswordHistory(ctx, id, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"new hash", "hash"}, history)
+	_, err = repo.SignUp(ctx, repository.UserInput{PhoneNumber: "+861381234567890", FullName: "Li Wei", Password: []byte("hash")})
+	assert.NoError(t, err)
+
+	// Every migration can be reverted, and applied again.
+	_, err = repo.Db.Exec("DELETE FROM users WHERE phone_number = '+861381234567890'")
+	assert.NoError(t, err)
+	reverted, err := migrator.Down(ctx, len(migrator.Migrations))
+	assert.NoError(t, err)
+	assert.Len(t, reverted, len(migrator.Migrations))
+	_, err = migrator.Up(ctx)
+	assert.NoError(t, err)
+}
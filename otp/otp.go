@@ -0,0 +1,108 @@
+// Package otp issues and checks the short numeric one-time codes that are
+// sent by SMS, e.g. to prove that a user owns a phone number.
+package otp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrExpired         = errors.New("otp: code expired")
+	ErrUsed            = errors.New("otp: code was already used")
+	ErrTooManyAttempts = errors.New("otp: too many attempts")
+	ErrMismatch        = errors.New("otp: code does not match")
+)
+
+// Policy controls the codes that are issued and how they may be used.
+type Policy struct {
+	// Digits is the length of the codes.
+	Digits int
+	// TTL is how long a code can be used after it was sent.
+	TTL time.Duration
+	// MaxAttempts is how many guesses are allowed per code.
+	MaxAttempts int
+	// ResendInterval is how long to wait before a new code can be sent.
+	ResendInterval time.Duration
+}
+
+var DefaultPolicy = Policy{
+	Digits:         6,
+	TTL:            5 * time.Minute,
+	MaxAttempts:    5,
+	ResendInterval: time.Minute,
+}
+
+// Code is an issued code as it is stored. The code itself is only kept as
+// a hash.
+type Code struct {
+	Hash       string
+	Attempts   int
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+}
+
+// Generate returns a random code of the policy's length.
+func (p Policy) Generate() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < p.Digits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	code := n.String()
+	for len(code) < p.Digits {
+		code = "0" + code
+	}
+	return code, nil
+}
+
+// Hash binds a code to the subject it was issued for, such as the purpose,
+// user and phone number, so that a code cannot be used for anything else.
+func Hash(subject, code string) string {
+	sum := sha256.Sum256([]byte(subject + "\x00" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// Usable reports why a stored code can no longer be checked, or nil when it
+// can. c.Attempts must already include the attempt being made, so that
+// concurrent guesses cannot exceed the limit.
+func (p Policy) Usable(c Code, now time.Time) error {
+	switch {
+	case c.ConsumedAt != nil:
+		return ErrUsed
+	case !now.Before(c.ExpiresAt):
+		return ErrExpired
+	case c.Attempts > p.MaxAttempts:
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+// Match compares a code against the stored hash in constant time.
+func Match(c Code, subject, code string) error {
+	if subtle.ConstantTimeCompare([]byte(c.Hash), []byte(Hash(subject, code))) != 1 {
+		return ErrMismatch
+	}
+	return nil
+}
+
+// ResendAfter returns how long to wait before a new code may replace c, or
+// zero when one can be sent right away.
+func (p Policy) ResendAfter(c Code, now time.Time) time.Duration {
+	if c.ConsumedAt != nil {
+		return 0
+	}
+	if wait := c.CreatedAt.Add(p.ResendInterval).Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+package otp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Generate(t *testing.T) {
+	p := Policy{Digits: 6}
+	for i := 0; i < 100; i++ {
+		code, err := p.Generate()
+		assert.NoError(t, err)
+		assert.Regexp(t, `^[0-9]{6}$`, code)
+	}
+}
+
+func Test_Policy(t *testing.T) {
+	now := time.Now()
+	p := DefaultPolicy
+	c := Code{
+		Hash:      Hash("verify_phone:1:+62888732928", "123456"),
+		Attempts:  1,
+		CreatedAt: now,
+		ExpiresAt: now.Add(p.TTL),
+	}
+	assert.NoError(t, p.Usable(c, now))
+	assert.NoError(t, Match(c, "verify_phone:1:+62888732928", "123456"))
+	assert.ErrorIs(t, Match(c, "verify_phone:1:+62888732928", "123457"), ErrMismatch)
+	assert.ErrorIs(t, Match(c, "change_phone:1:+62888732928", "123456"), ErrMismatch)
+
+	assert.ErrorIs(t, p.Usable(c, now.Add(p.TTL)), ErrExpired)
+	tooMany := c
+	tooMany.Attempts = p.MaxAttempts + 1
+	assert.ErrorIs(t, p.Usable(tooMany, now), ErrTooManyAttempts)
+	used := c
+	used.ConsumedAt = &now
+	assert.ErrorIs(t, p.Usable(used, now), ErrUsed)
+
+	assert.Equal(t, p.ResendInterval, p.ResendAfter(c, now))
+	assert.Equal(t, time.Duration(0), p.ResendAfter(c, now.Add(p.ResendInterval)))
+	assert.Equal(t, time.Duration(0), p.ResendAfter(used, now))
+}
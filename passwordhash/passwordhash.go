@@ -0,0 +1,209 @@
+// Package passwordhash hashes passwords into self-describing strings and
+// verifies them, so that the algorithm and its costs can be raised over time
+// without invalidating stored hashes.
+//
+// Argon2id hashes are written in the PHC string format:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+//
+// bcrypt hashes keep their usual "$2a$<cost>$..." form, which the PHC format
+// adopts as is.
+package passwordhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type Algorithm string
+
+const (
+	Argon2id Algorithm = "argon2id"
+	Bcrypt   Algorithm = "bcrypt"
+)
+
+var (
+	ErrUnknownFormat = errors.New("passwordhash: unknown hash format")
+	ErrMalformedHash = errors.New("passwordhash: malformed hash")
+)
+
+// Argon2idParams are the costs of Argon2id. Memory is in KiB.
+type Argon2idParams struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idParams follow the second recommendation of RFC 9106 for
+// memory constrained environments.
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// BcryptMaxBytes is the size of the longest password bcrypt hashes.
+const BcryptMaxBytes = 72
+
+// Policy decides how new hashes are made. Hashes made under another policy
+// are still verified, and NeedsRehash tells when to replace them.
+type Policy struct {
+	Algorithm  Algorithm
+	Argon2id   Argon2idParams
+	BcryptCost int
+}
+
+var DefaultPolicy = Policy{
+	Algorithm:  Argon2id,
+	Argon2id:   DefaultArgon2idParams,
+	BcryptCost: bcrypt.DefaultCost,
+}
+
+// Validate reports settings that cannot make a hash.
+func (p Policy) Validate() error {
+	switch p.Algorithm {
+	case Argon2id:
+		a := p.Argon2id
+		if a.Memory < 8*uint32(a.Parallelism) || a.Iterations < 1 || a.Parallelism < 1 || a.SaltLength < 8 || a.KeyLength < 16 {
+			return fmt.Errorf("passwordhash: invalid argon2id parameters %+v", a)
+		}
+	case Bcrypt:
+		if p.BcryptCost < bcrypt.MinCost || p.BcryptCost > bcrypt.MaxCost {
+			return fmt.Errorf("passwordhash: bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	default:
+		return fmt.Errorf("passwordhash: unknown algorithm %q", p.Algorithm)
+	}
+	return nil
+}
+
+// MaxPasswordBytes returns the size of the longest password the algorithm
+// of the policy can hash, or zero when it has no limit.
+func (p Policy) MaxPasswordBytes() int {
+	if p.Algorithm == Bcrypt {
+		return BcryptMaxBytes
+	}
+	return 0
+}
+
+// Hash hashes password with the algorithm and costs of the policy.
+func (p Policy) Hash(password string) (string, error) {
+	switch p.Algorithm {
+	case Argon2id:
+		salt := make([]byte, p.Argon2id.SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		a := p.Argon2id
+		key := argon2.IDKey([]byte(password), salt, a.Iterations, a.Memory, a.Parallelism, a.KeyLength)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, a.Memory, a.Iterations, a.Parallelism,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	case Bcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), p.BcryptCost)
+		return string(hash), err
+	}
+	return "", fmt.Errorf("passwordhash: unknown algorithm %q", p.Algorithm)
+}
+
+// Verify reports whether password matches the encoded hash, whichever
+// supported algorithm made it.
+func Verify(password, encoded string) (bool, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		params, salt, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return false, err
+		}
+		other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	case isBcrypt(encoded):
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, ErrMalformedHash
+		}
+		return true, nil
+	}
+	return false, ErrUnknownFormat
+}
+
+// AlgorithmOf returns the algorithm that made the encoded hash, or an empty
+// Algorithm when no supported one did.
+func AlgorithmOf(encoded string) Algorithm {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return Argon2id
+	case isBcrypt(encoded):
+		return Bcrypt
+	}
+	return ""
+}
+
+// NeedsRehash reports whether the encoded hash was made with another
+// algorithm or other costs than the policy's, so that it should be replaced
+// the next time the password is known.
+func (p Policy) NeedsRehash(encoded string) bool {
+	switch p.Algorithm {
+	case Argon2id:
+		params, salt, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return true
+		}
+		params.SaltLength = uint32(len(salt))
+		params.KeyLength = uint32(len(key))
+		return params != p.Argon2id
+	case Bcrypt:
+		if !isBcrypt(encoded) {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(encoded))
+		return err != nil || cost != p.BcryptCost
+	}
+	return false
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+func decodeArgon2id(encoded string) (params Argon2idParams, salt, key []byte, err error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != string(Argon2id) {
+		return params, nil, nil, ErrMalformedHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrMalformedHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrMalformedHash
+	}
+	if params.Iterations < 1 || params.Parallelism < 1 {
+		return params, nil, nil, ErrMalformedHash
+	}
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrMalformedHash
+	}
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrMalformedHash
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
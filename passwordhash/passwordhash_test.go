@@ -0,0 +1,99 @@
+package passwordhash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// fastArgon2id keeps the tests quick; production uses DefaultArgon2idParams.
+var fastArgon2id = Policy{
+	Algorithm: Argon2id,
+	Argon2id: Argon2idParams{
+		Memory:      1024,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	},
+}
+
+func Test_HashAndVerify(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		prefix string
+	}{
+		{name: "argon2id", policy: fastArgon2id, prefix: "$argon2id$v=19$m=1024,t=1,p=1$"},
+		{name: "bcrypt", policy: Policy{Algorithm: Bcrypt, BcryptCost: 4}, prefix: "$2a$04$"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.NoError(t, test.policy.Validate())
+			hash, err := test.policy.Hash("aaaaA1&")
+			assert.NoError(t, err)
+			assert.Contains(t, hash, test.prefix)
+
+			ok, err := Verify("aaaaA1&", hash)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			ok, err = Verify("aaaaA1!", hash)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+			assert.False(t, test.policy.NeedsRehash(hash))
+		})
+	}
+}
+
+func Test_Verify_ExistingHashes(t *testing.T) {
+	// Hash of "aaaaA1&" stored by earlier versions with bcrypt.DefaultCost.
+	ok, err := Verify("aaaaA1&", "$2a$10$aWgB75Bp8DtTygKxoKXUsuGA2cE/eycXqT3YvoproS9BAJiu5fpSS")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// Hash of "password" with salt "somesalt", m=64, t=2, p=1.
+	ok, err = Verify("password", "$argon2id$v=19$m=64,t=2,p=1$c29tZXNhbHQ$FqGkmHNGCd0BRW2kBt6fPZ2pPmyGwwChL8FGUhTOSSI")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = Verify("aaaaA1&", "plaintext")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+	_, err = Verify("aaaaA1&", "$argon2id$v=19$m=64$c29tZXNhbHQ$aGFzaA")
+	assert.ErrorIs(t, err, ErrMalformedHash)
+}
+
+func Test_NeedsRehash(t *testing.T) {
+	bcrypt10 := "$2a$10$aWgB75Bp8DtTygKxoKXUsuGA2cE/eycXqT3YvoproS9BAJiu5fpSS"
+	weaker, err := fastArgon2id.Hash("aaaaA1&")
+	assert.NoError(t, err)
+
+	stronger := fastArgon2id
+	stronger.Argon2id.Iterations = 2
+	assert.True(t, stronger.NeedsRehash(weaker))
+	assert.True(t, stronger.NeedsRehash(bcrypt10))
+	assert.False(t, Policy{Algorithm: Bcrypt, BcryptCost: 10}.NeedsRehash(bcrypt10))
+	assert.True(t, Policy{Algorithm: Bcrypt, BcryptCost: 12}.NeedsRehash(bcrypt10))
+	assert.True(t, Policy{Algorithm: Bcrypt, BcryptCost: 10}.NeedsRehash(weaker))
+}
+
+func Test_Policy_MaxPasswordBytes(t *testing.T) {
+	assert.Equal(t, 0, DefaultPolicy.MaxPasswordBytes())
+	bcryptPolicy := Policy{Algorithm: Bcrypt, BcryptCost: bcrypt.MinCost}
+	assert.Equal(t, BcryptMaxBytes, bcryptPolicy.MaxPasswordBytes())
+
+	// The longest password bcrypt takes can be hashed, and longer ones
+	// cannot.
+	_, err := bcryptPolicy.Hash(strings.Repeat("é", BcryptMaxBytes/2))
+	assert.NoError(t, err)
+	_, err = bcryptPolicy.Hash(strings.Repeat("é", BcryptMaxBytes/2+1))
+	assert.Error(t, err)
+}
+
+func Test_AlgorithmOf(t *testing.T) {
+	assert.Equal(t, Bcrypt, AlgorithmOf("$2a$10$aWgB75Bp8DtTygKxoKXUsuGA2cE/eycXqT3YvoproS9BAJiu5fpSS"))
+	assert.Equal(t, Argon2id, AlgorithmOf("$argon2id$v=19$m=64,t=2,p=1$c29tZXNhbHQ$FqGkmHNGCd0BRW2kBt6fPZ2pPmyGwwChL8FGUhTOSSI"))
+	assert.Equal(t, Algorithm(""), AlgorithmOf("plaintext"))
+	assert.Equal(t, Algorithm(""), AlgorithmOf(""))
+}
@@ -0,0 +1,178 @@
+// Package passwordpolicy decides which passwords users may choose. A policy
+// reports every rule a password breaks, each with a stable code that clients
+// can act on.
+package passwordpolicy
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SawitProRecruitment/UserService/phone"
+)
+
+// Codes of the rules a password can break.
+const (
+	CodeTooShort           = "password_too_short"
+	CodeTooLong            = "password_too_long"
+	CodeTooManyBytes       = "password_too_many_bytes"
+	CodeMissingUppercase   = "password_missing_uppercase"
+	CodeMissingLowercase   = "password_missing_lowercase"
+	CodeMissingDigit       = "password_missing_digit"
+	CodeMissingSymbol      = "password_missing_symbol"
+	CodeRepeatedCharacters = "password_repeated_characters"
+	CodePersonalInfo       = "password_contains_personal_info"
+	CodeBannedSubstring    = "password_contains_banned_substring"
+)
+
+// minPersonalInfoLength is the shortest part of a name that a password may
+// not contain. Shorter parts are too common to forbid.
+const minPersonalInfoLength = 3
+
+// Policy lists the rules of a password. Lengths count characters, not bytes.
+type Policy struct {
+	MinLength int
+	MaxLength int
+	// MaxBytes bounds the size of a password in UTF-8, for hashing
+	// algorithms such as bcrypt that only read so many bytes. Zero allows
+	// any size.
+	MaxBytes      int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// MaxRepeated is the longest run of one character a password may have.
+	// Zero allows any run.
+	MaxRepeated int
+	// BanPersonalInfo forbids the parts of the user's name and the digits of
+	// their phone number.
+	BanPersonalInfo bool
+	// BannedSubstrings are forbidden regardless of case.
+	BannedSubstrings []string
+}
+
+// DefaultPolicy keeps the rules passwords always had.
+var DefaultPolicy = Policy{
+	MinLength:     6,
+	MaxLength:     64,
+	RequireUpper:  true,
+	RequireDigit:  true,
+	RequireSymbol: true,
+}
+
+// User is what a policy knows about the owner of a password.
+type User struct {
+	FullName string
+	// PhoneNumber is in E.164 format.
+	PhoneNumber string
+}
+
+// Violation is a rule that a password breaks. Callers word the message of
+// each code themselves, in the language of the user.
+type Violation struct {
+	Code string
+	// Limit is the bound of the rules that have one, such as the minimum
+	// length.
+	Limit int
+}
+
+// Validate reports rules that no password could follow.
+func (p Policy) Validate() error {
+	if p.MinLength < 1 {
+		return errors.New("passwordpolicy: minimum length must be positive")
+	}
+	if p.MaxLength < p.MinLength {
+		return errors.New("passwordpolicy: maximum length must not be below the minimum length")
+	}
+	if p.MaxBytes < 0 {
+		return errors.New("passwordpolicy: maximum size must not be negative")
+	}
+	if p.MaxRepeated < 0 {
+		return errors.New("passwordpolicy: maximum repeated characters must not be negative")
+	}
+	return nil
+}
+
+// Check returns every rule of the policy that password breaks, in a fixed
+// order. It returns nil when the password may be used.
+func (p Policy) Check(password string, user User) []Violation {
+	var violations []Violation
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		violations = append(violations, Violation{Code: CodeTooShort, Limit: p.MinLength})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, Violation{Code: CodeTooLong, Limit: p.MaxLength})
+	} else if p.MaxBytes > 0 && len(password) > p.MaxBytes {
+		violations = append(violations, Violation{Code: CodeTooManyBytes, Limit: p.MaxBytes})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	run, longestRun := 0, 0
+	var previous rune
+	for i, char := range password {
+		switch {
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char):
+			hasSymbol = true
+		}
+		if i > 0 && char == previous {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longestRun {
+			longestRun = run
+		}
+		previous = char
+	}
+	if p.RequireUpper && !hasUpper {
+		violations = append(violations, Violation{Code: CodeMissingUppercase})
+	}
+	if p.RequireLower && !hasLower {
+		violations = append(violations, Violation{Code: CodeMissingLowercase})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, Violation{Code: CodeMissingDigit})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, Violation{Code: CodeMissingSymbol})
+	}
+	if p.MaxRepeated > 0 && longestRun > p.MaxRepeated {
+		violations = append(violations, Violation{Code: CodeRepeatedCharacters, Limit: p.MaxRepeated})
+	}
+
+	lower := strings.ToLower(password)
+	if p.BanPersonalInfo && containsPersonalInfo(lower, user) {
+		violations = append(violations, Violation{Code: CodePersonalInfo})
+	}
+	for _, banned := range p.BannedSubstrings {
+		if banned != "" && strings.Contains(lower, strings.ToLower(banned)) {
+			violations = append(violations, Violation{Code: CodeBannedSubstring})
+			break
+		}
+	}
+	return violations
+}
+
+func containsPersonalInfo(lowerPassword string, user User) bool {
+	for _, part := range strings.Fields(strings.ToLower(user.FullName)) {
+		if utf8.RuneCountInString(part) >= minPersonalInfoLength && strings.Contains(lowerPassword, part) {
+			return true
+		}
+	}
+
+	// The national number without the country code or trunk prefix is what
+	// people remember, so it is forbidden in any of its written forms.
+	number, err := phone.Parse(user.PhoneNumber, "")
+	if err != nil {
+		return false
+	}
+	return len(number.National) >= 6 && strings.Contains(lowerPassword, number.National)
+}
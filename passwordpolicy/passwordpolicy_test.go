@@ -0,0 +1,65 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Check(t *testing.T) {
+	user := User{FullName: "Budi Santoso", PhoneNumber: "+62812734928"}
+	strict := Policy{
+		MinLength:        8,
+		MaxLength:        16,
+		RequireUpper:     true,
+		RequireLower:     true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		MaxRepeated:      2,
+		BanPersonalInfo:  true,
+		BannedSubstrings: []string{"Sawit"},
+	}
+	tests := []struct {
+		name     string
+		policy   Policy
+		user     User
+		password string
+		want     []string
+	}{
+		{name: "default accepts", policy: DefaultPolicy, password: "aaaaA1&"},
+		{name: "default rejects every class", policy: DefaultPolicy, password: "aaaa", want: []string{CodeTooShort, CodeMissingUppercase, CodeMissingDigit, CodeMissingSymbol}},
+		{name: "too long", policy: DefaultPolicy, password: "A1&" + string(make([]byte, 62)), want: []string{CodeTooLong}},
+		{name: "length counts characters", policy: DefaultPolicy, password: "ééA1&é"},
+		{name: "too many bytes", policy: Policy{MinLength: 1, MaxLength: 64, MaxBytes: 72}, password: strings.Repeat("é", 37), want: []string{CodeTooManyBytes}},
+		{name: "bytes within limit", policy: Policy{MinLength: 1, MaxLength: 64, MaxBytes: 72}, password: strings.Repeat("é", 36)},
+		{name: "strict accepts", policy: strict, password: "Kebun-Sawi7"},
+		{name: "repeated characters", policy: strict, password: "Kebuuun-7", want: []string{CodeRepeatedCharacters}},
+		{name: "missing lowercase", policy: strict, password: "KEBUN-77X", want: []string{CodeMissingLowercase}},
+		{name: "name", policy: strict, password: "xSantoso-7", want: []string{CodePersonalInfo}},
+		{name: "international phone", policy: strict, password: "A-812734928b", want: []string{CodePersonalInfo}},
+		{name: "local phone", policy: strict, password: "A-0812734928b", want: []string{CodePersonalInfo}},
+		{name: "foreign phone", policy: strict, user: User{PhoneNumber: "+14156172671"}, password: "xY-4156172671", want: []string{CodePersonalInfo}},
+		{name: "banned substring", policy: strict, password: "mySAWIT-77x", want: []string{CodeBannedSubstring}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			u := user
+			if test.user != (User{}) {
+				u = test.user
+			}
+			for _, v := range test.policy.Check(test.password, u) {
+				got = append(got, v.Code)
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	assert.NoError(t, DefaultPolicy.Validate())
+	assert.Error(t, Policy{MinLength: 0, MaxLength: 10}.Validate())
+	assert.Error(t, Policy{MinLength: 8, MaxLength: 6}.Validate())
+	assert.Error(t, Policy{MinLength: 6, MaxLength: 64, MaxRepeated: -1}.Validate())
+}
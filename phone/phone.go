@@ -0,0 +1,203 @@
+// Package phone parses phone numbers written in local or international
+// formats and normalizes them to E.164, such as "+628123456789", which is
+// the form numbers are stored and compared in.
+package phone
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed metadata.txt
+var metadata string
+
+var (
+	ErrInvalid        = errors.New("phone: not a phone number")
+	ErrUnknownCountry = errors.New("phone: unsupported country")
+	ErrInvalidLength  = errors.New("phone: wrong number of digits for the country")
+)
+
+// maxDigits is the most digits of an E.164 number, calling code included.
+const maxDigits = 15
+
+// country holds what the metadata knows about a region.
+type country struct {
+	region      string
+	callingCode string
+	trunkPrefix string
+	minLength   int
+	maxLength   int
+	// leadingDigits tell the national numbers of the region apart from
+	// those of the other regions of its calling code.
+	leadingDigits []string
+}
+
+var (
+	byRegion = map[string]country{}
+	// byCallingCode lists the regions of each calling code in the order of
+	// the metadata.
+	byCallingCode = map[string][]country{}
+)
+
+func init() {
+	for n, line := range strings.Split(metadata, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		c, err := parseCountry(line)
+		if err != nil {
+			panic(fmt.Sprintf("phone: metadata line %d: %v", n+1, err))
+		}
+		byRegion[c.region] = c
+		byCallingCode[c.callingCode] = append(byCallingCode[c.callingCode], c)
+	}
+}
+
+func parseCountry(line string) (c country, err error) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 && len(fields) != 5 {
+		return c, errors.New("want 4 or 5 fields")
+	}
+	if len(fields) == 5 {
+		c.leadingDigits = strings.Split(fields[4], ",")
+	}
+	c.region, c.callingCode, c.trunkPrefix = fields[0], fields[1], fields[2]
+	if c.trunkPrefix == "-" {
+		c.trunkPrefix = ""
+	}
+	min, max, ok := strings.Cut(fields[3], "-")
+	if !ok {
+		return c, errors.New("lengths must be <min>-<max>")
+	}
+	if c.minLength, err = strconv.Atoi(min); err != nil {
+		return c, err
+	}
+	if c.maxLength, err = strconv.Atoi(max); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// Number is a parsed phone number.
+type Number struct {
+	// Region is the ISO 3166-1 code of the country, such as "ID". Numbers of
+	// calling codes shared by several countries get the one their leading
+	// digits belong to, such as "CA" for "+1 604".
+	Region string
+	// CallingCode is the country calling code without "+", such as "62".
+	CallingCode string
+	// National is the national significant number, which leaves out the
+	// trunk prefix, such as "8123456789".
+	National string
+}
+
+// E164 formats the number as "+" followed by its digits.
+func (n Number) E164() string {
+	return "+" + n.CallingCode + n.National
+}
+
+// Parse reads a number in international format, starting with "+" or "00",
+// or in the national format of defaultRegion, such as "0812-3456-789" for
+// "ID". Spaces, dashes, dots, slashes and parentheses are ignored.
+func Parse(raw, defaultRegion string) (Number, error) {
+	raw = strings.TrimSpace(raw)
+	international := strings.HasPrefix(raw, "+")
+	raw = strings.TrimPrefix(raw, "+")
+
+	digits := make([]byte, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		switch char := raw[i]; {
+		case '0' <= char && char <= '9':
+			digits = append(digits, char)
+		case strings.IndexByte(" -./()", char) >= 0:
+		default:
+			return Number{}, ErrInvalid
+		}
+	}
+	number := string(digits)
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+	if number == "" {
+		return Number{}, ErrInvalid
+	}
+
+	var c country
+	var national string
+	if international {
+		var countries []country
+		for i := 1; i <= 3 && i <= len(number); i++ {
+			if countries = byCallingCode[number[:i]]; countries != nil {
+				national = number[i:]
+				break
+			}
+		}
+		if countries == nil {
+			return Number{}, ErrUnknownCountry
+		}
+		// The regions of a calling code share the trunk prefix and the
+		// lengths.
+		c = countries[0]
+		// "+62 0812..." repeats the trunk prefix, which is a common mistake.
+		if c.trunkPrefix != "" && strings.HasPrefix(national, c.trunkPrefix) && validLength(c, len(national)-len(c.trunkPrefix)) {
+			national = national[len(c.trunkPrefix):]
+		}
+		c = regionOf(countries, national)
+	} else {
+		var ok bool
+		if c, ok = byRegion[strings.ToUpper(defaultRegion)]; !ok {
+			return Number{}, ErrUnknownCountry
+		}
+		national = number
+		if c.trunkPrefix != "" && strings.HasPrefix(national, c.trunkPrefix) {
+			national = national[len(c.trunkPrefix):]
+		}
+		c = regionOf(byCallingCode[c.callingCode], national)
+	}
+
+	if !validLength(c, len(national)) || len(c.callingCode)+len(national) > maxDigits {
+		return Number{}, ErrInvalidLength
+	}
+	return Number{Region: c.region, CallingCode: c.callingCode, National: national}, nil
+}
+
+// regionOf returns the region of countries, which share a calling code,
+// that national belongs to.
+func regionOf(countries []country, national string) country {
+	main := countries[0]
+	for _, c := range countries {
+		if c.leadingDigits == nil {
+			main = c
+		}
+		for _, prefix := range c.leadingDigits {
+			if strings.HasPrefix(national, prefix) {
+				return c
+			}
+		}
+	}
+	return main
+}
+
+func validLength(c country, n int) bool {
+	return c.minLength <= n && n <= c.maxLength
+}
+
+// Normalize parses raw like Parse does and returns it in E.164 format.
+func Normalize(raw, defaultRegion string) (string, error) {
+	n, err := Parse(raw, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return n.E164(), nil
+}
+
+// KnownRegion reports whether the metadata has the region.
+func KnownRegion(region string) bool {
+	_, ok := byRegion[strings.ToUpper(region)]
+	return ok
+}
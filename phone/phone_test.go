@@ -0,0 +1,66 @@
+package phone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Normalize(t *testing.T) {
+	tests := []struct {
+		raw    string
+		region string
+		want   string
+		err    error
+	}{
+		{raw: "+628123456789", region: "ID", want: "+628123456789"},
+		{raw: "08123456789", region: "ID", want: "+628123456789"},
+		{raw: "0812-3456-789", region: "ID", want: "+628123456789"},
+		{raw: "+62 812-3456-789", region: "ID", want: "+628123456789"},
+		{raw: "+62 (0)812 3456 789", region: "ID", want: "+628123456789"},
+		{raw: "00628123456789", region: "ID", want: "+628123456789"},
+		{raw: "+62888732928", region: "", want: "+62888732928"},
+		{raw: "+65 6123 4567", region: "ID", want: "+6561234567"},
+		{raw: "(415) 555-2671", region: "us", want: "+14155552671"},
+		{raw: "1 415 555 2671", region: "US", want: "+14155552671"},
+		{raw: "+44 20 7946 0958", region: "ID", want: "+442079460958"},
+		{raw: "020 7946 0958", region: "GB", want: "+442079460958"},
+		{raw: "8 912 345-67-89", region: "RU", want: "+79123456789"},
+		{raw: "06 1234 5678", region: "IT", want: "+390612345678"},
+		{raw: "", region: "ID", err: ErrInvalid},
+		{raw: "+", region: "ID", err: ErrInvalid},
+		{raw: "0812abc", region: "ID", err: ErrInvalid},
+		{raw: "+62812#3456", region: "ID", err: ErrInvalid},
+		{raw: "08123", region: "ID", err: ErrInvalidLength},
+		{raw: "+628123456789012", region: "ID", err: ErrInvalidLength},
+		{raw: "+65 6123 456", region: "ID", err: ErrInvalidLength},
+		{raw: "+999 1234 5678", region: "ID", err: ErrUnknownCountry},
+		{raw: "08123456789", region: "", err: ErrUnknownCountry},
+		{raw: "08123456789", region: "XX", err: ErrUnknownCountry},
+	}
+	for _, test := range tests {
+		got, err := Normalize(test.raw, test.region)
+		assert.Equal(t, test.want, got, test.raw)
+		assert.ErrorIs(t, err, test.err, test.raw)
+	}
+}
+
+func Test_Parse(t *testing.T) {
+	n, err := Parse("+1 604 555 0123", "ID")
+	assert.NoError(t, err)
+	assert.Equal(t, Number{Region: "CA", CallingCode: "1", National: "6045550123"}, n)
+
+	n, err = Parse("+1 415 555 2671", "ID")
+	assert.NoError(t, err)
+	assert.Equal(t, Number{Region: "US", CallingCode: "1", National: "4155552671"}, n)
+
+	n, err = Parse("(604) 555-0123", "US")
+	assert.NoError(t, err)
+	assert.Equal(t, "CA", n.Region)
+
+	n, err = Parse("0812 3456 789", "ID")
+	assert.NoError(t, err)
+	assert.Equal(t, Number{Region: "ID", CallingCode: "62", National: "8123456789"}, n)
+	assert.True(t, KnownRegion("id"))
+	assert.False(t, KnownRegion("XX"))
+}
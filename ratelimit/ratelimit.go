@@ -0,0 +1,99 @@
+// Package ratelimit holds the rate limits of the routes that guess
+// credentials. The limits are enforced by the handler against a token
+// bucket store.
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit allows bursts of up to Requests requests and regains the whole
+// allowance over Per. The zero value does not limit anything.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Parse parses limits written as "<requests>/<duration>", such as "5/1m".
+// An empty string or "off" disables the limit.
+func Parse(s string) (Limit, error) {
+	if s == "" || s == "off" {
+		return Limit{}, nil
+	}
+	requests, per, ok := strings.Cut(s, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate limit %q is not in the form <requests>/<duration>", s)
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("rate limit %q must allow a positive number of requests", s)
+	}
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("rate limit %q must have a positive duration", s)
+	}
+	return Limit{Requests: n, Per: d}, nil
+}
+
+// String writes l the way Parse reads it.
+func (l Limit) String() string {
+	if !l.Enabled() {
+		return "off"
+	}
+	return fmt.Sprintf("%d/%s", l.Requests, l.Per)
+}
+
+// Enabled reports whether l limits anything.
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Per > 0
+}
+
+// Rule limits a route per client IP and per phone number. A request must fit
+// within both limits.
+type Rule struct {
+	PerIP    Limit
+	PerPhone Limit
+}
+
+// Defaults throttles the routes that guess credentials, keyed by
+// "METHOD /path" of the v1 route as registered in echo. The /v2 routes share
+// the limits, and the buckets, of the v1 routes they stand for.
+var Defaults = map[string]Rule{
+	"POST /login": {
+		PerIP:    Limit{Requests: 20, Per: time.Minute},
+		PerPhone: Limit{Requests: 10, Per: time.Minute},
+	},
+	"POST /login/mfa": {
+		PerIP: Limit{Requests: 20, Per: time.Minute},
+	},
+	"POST /my-phone/confirm": {
+		PerIP:    Limit{Requests: 20, Per: time.Minute},
+		PerPhone: Limit{Requests: 10, Per: time.Hour},
+	},
+	"PATCH /update-my-profile": {
+		PerPhone: Limit{Requests: 5, Per: time.Hour},
+	},
+	"POST /password/forgot": {
+		PerIP:    Limit{Requests: 5, Per: time.Minute},
+		PerPhone: Limit{Requests: 5, Per: time.Hour},
+	},
+	"POST /password/reset": {
+		PerIP:    Limit{Requests: 20, Per: time.Minute},
+		PerPhone: Limit{Requests: 10, Per: time.Hour},
+	},
+	"POST /phone-verification": {
+		PerIP:    Limit{Requests: 5, Per: time.Minute},
+		PerPhone: Limit{Requests: 5, Per: time.Hour},
+	},
+	"POST /phone-verification/confirm": {
+		PerIP:    Limit{Requests: 20, Per: time.Minute},
+		PerPhone: Limit{Requests: 10, Per: time.Hour},
+	},
+	"POST /signup": {
+		PerIP:    Limit{Requests: 5, Per: time.Minute},
+		PerPhone: Limit{Requests: 3, Per: time.Hour},
+	},
+}
@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Limit
+		err  bool
+	}{
+		{in: "", want: Limit{}},
+		{in: "off", want: Limit{}},
+		{in: "5/1m", want: Limit{Requests: 5, Per: time.Minute}},
+		{in: "100/1h30m", want: Limit{Requests: 100, Per: 90 * time.Minute}},
+		{in: "5", err: true},
+		{in: "0/1m", err: true},
+		{in: "5/0s", err: true},
+		{in: "five/1m", err: true},
+	}
+	for _, test := range tests {
+		got, err := Parse(test.in)
+		if test.err {
+			assert.Error(t, err, test.in)
+			continue
+		}
+		assert.NoError(t, err, test.in)
+		assert.Equal(t, test.want, got, test.in)
+	}
+}
+
+func Test_Limit_String(t *testing.T) {
+	for _, limit := range []Limit{{}, {Requests: 5, Per: time.Minute}, {Requests: 100, Per: 90 * time.Minute}} {
+		got, err := Parse(limit.String())
+		assert.NoError(t, err, limit.String())
+		assert.Equal(t, limit, got)
+	}
+	assert.Equal(t, "off", Limit{}.String())
+	assert.Equal(t, "5/1m0s", Limit{Requests: 5, Per: time.Minute}.String())
+}
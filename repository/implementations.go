@@ -2,11 +2,16 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"log"
+	"time"
+
+	"github.com/SawitProRecruitment/UserService/metrics"
 )
 
 // GetTestById returns user's name for example function
 func (r *Repository) GetTestById(ctx context.Context, input GetTestByIdInput) (output QueryOutput, err error) {
+	defer metrics.ObserveQuery("GetTestById", time.Now(), &err)
 	err = r.Db.QueryRowContext(ctx, "SELECT full_name FROM users WHERE id = $1", input.Id).Scan(&output.Name)
 	if err != nil {
 		log.Println("error querying err:", err)
@@ -17,6 +22,7 @@ func (r *Repository) GetTestById(ctx context.Context, input GetTestByIdInput) (o
 
 // SignUp fuction to register user account
 func (r *Repository) SignUp(ctx context.Context, input UserInput) (output QueryOutput, err error) {
+	defer metrics.ObserveQuery("SignUp", time.Now(), &err)
     err = r.Db.QueryRowContext(ctx, "INSERT INTO users (phone_number, full_name, password_hash) VALUES ($1, $2, $3) RETURNING id", input.PhoneNumber, input.FullName, input.Password).Scan(&output.ID)
     if err != nil {
         log.Println("error querying sign up user err:", err)
@@ -28,41 +34,625 @@ func (r *Repository) SignUp(ctx context.Context, input UserInput) (output QueryO
 
 // GetUserData fuction to get user account information
 func (r *Repository) GetUserData(ctx context.Context, input UserInput) (output QueryOutput, err error) {
-	err = r.Db.QueryRowContext(ctx, "SELECT full_name,password_hash FROM users WHERE phone_number = $1", input.PhoneNumber).Scan(&output.Name, &output.Password)
+	defer metrics.ObserveQuery("GetUserData", time.Now(), &err)
+	var lockedUntil sql.NullTime
+	err = r.Db.QueryRowContext(ctx, "SELECT id,full_name,password_hash,is_admin,locked_until,totp_enabled_at IS NOT NULL,phone_verified_at IS NOT NULL,COALESCE(language, '') FROM users WHERE phone_number = $1", input.PhoneNumber).Scan(&output.ID, &output.Name, &output.Password, &output.IsAdmin, &lockedUntil, &output.TOTPEnabled, &output.PhoneVerified, &output.Language)
 	if err != nil {
 		log.Println("error querying get user data err:", err)
 		return
 	}
+	if lockedUntil.Valid {
+		output.LockedUntil = &lockedUntil.Time
+	}
+	return
+}
+
+// UpdateUserByID function to update the name, phone number and/or language
+// of a user. Empty fields of input are left unchanged.
+func (r *Repository) UpdateUserByID(ctx context.Context, id int, input UserInput) (err error) {
+	defer metrics.ObserveQuery("UpdateUserByID", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE users SET full_name = COALESCE(NULLIF($2, ''), full_name), phone_number = COALESCE(NULLIF($3, ''), phone_number), language = COALESCE(NULLIF($4, ''), language) WHERE id = $1", id, input.FullName, input.PhoneNumber, input.Language)
+	if err != nil {
+		log.Println("error querying update user err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
 	return
 }
 
-// UpdateName fuction to update user name
-func (r *Repository) UpdateName(ctx context.Context, newName string, oldName string, phoneNumber string) (err error) {
-	_, err = r.Db.ExecContext(ctx, "UPDATE users SET full_name = $1 WHERE full_name = $2 AND phone_number = $3", newName, oldName, phoneNumber)
+// Logged function to increment user loggin count and clear failed attempts
+func (r *Repository) Logged(ctx context.Context, id int) (err error) {
+	defer metrics.ObserveQuery("Logged", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "UPDATE users SET successful_login = successful_login + 1, failed_login_attempts = 0, locked_until = NULL WHERE id = $1", id)
 	if err != nil {
-		log.Println("error querying update name err:", err)
+		log.Println("error querying increment login successful err:", err)
 		return err
 	}
+	return nil
+}
 
+// RecordFailedLogin function to count a failed login. Failures older than
+// resetBefore are forgotten first, so the count restarts after a cool-down.
+func (r *Repository) RecordFailedLogin(ctx context.Context, id int, resetBefore time.Time) (attempts int, err error) {
+	defer metrics.ObserveQuery("RecordFailedLogin", time.Now(), &err)
+	err = r.Db.QueryRowContext(ctx, `UPDATE users SET
+		failed_login_attempts = CASE WHEN last_failed_login_at < $2 THEN 1 ELSE failed_login_attempts + 1 END,
+		last_failed_login_at = now()
+		WHERE id = $1 RETURNING failed_login_attempts`, id, resetBefore).Scan(&attempts)
+	if err != nil {
+		log.Println("error querying record failed login err:", err)
+		return
+	}
 	return
 }
 
-// UpdatePhoneNumber function to update user number
-func (r *Repository) UpdatePhoneNumber(ctx context.Context, newNumber string, oldNumber string, fullName string) (err error) {
-	_, err = r.Db.ExecContext(ctx, "UPDATE users SET phone_number = $1 WHERE phone_number = $2 AND full_name = $3", newNumber, oldNumber, fullName)
+// LockUser function to block logins of a user until the given time
+func (r *Repository) LockUser(ctx context.Context, id int, until time.Time) (err error) {
+	defer metrics.ObserveQuery("LockUser", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "UPDATE users SET locked_until = $2 WHERE id = $1", id, until)
 	if err != nil {
-		log.Println("error querying update phone number err : ", err)
+		log.Println("error querying lock user err:", err)
 		return
 	}
 	return
 }
 
-// Logged function to increment user loggin count
-func (r *Repository) Logged(ctx context.Context, phoneNumber string) error {
-    _, err := r.Db.ExecContext(ctx, "UPDATE users SET successful_login = successful_login + 1 WHERE phone_number = $1", phoneNumber)
-    if err != nil {
-		log.Println("error querying increment login successful err:", err)
-        return err
-    }
-    return nil
-}
\ No newline at end of file
+// UnlockUser function to lift a lock and clear the failed attempts of a user
+func (r *Repository) UnlockUser(ctx context.Context, id int) (err error) {
+	defer metrics.ObserveQuery("UnlockUser", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE users SET locked_until = NULL, failed_login_attempts = 0 WHERE id = $1", id)
+	if err != nil {
+		log.Println("error querying unlock user err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return
+}
+
+// GetLoginState function to get the failed login counters of a user
+func (r *Repository) GetLoginState(ctx context.Context, id int) (output LoginStateOutput, err error) {
+	defer metrics.ObserveQuery("GetLoginState", time.Now(), &err)
+	var lastFailedAt, lockedUntil sql.NullTime
+	err = r.Db.QueryRowContext(ctx, "SELECT id, failed_login_attempts, last_failed_login_at, locked_until FROM users WHERE id = $1", id).
+		Scan(&output.UserID, &output.FailedLoginAttempts, &lastFailedAt, &lockedUntil)
+	if err != nil {
+		log.Println("error querying get login state err:", err)
+		return
+	}
+	if lastFailedAt.Valid {
+		output.LastFailedLoginAt = &lastFailedAt.Time
+	}
+	if lockedUntil.Valid {
+		output.LockedUntil = &lockedUntil.Time
+	}
+	return
+}
+
+// GetUserByID function to get user account information by primary key
+func (r *Repository) GetUserByID(ctx context.Context, id int) (output QueryOutput, err error) {
+	defer metrics.ObserveQuery("GetUserByID", time.Now(), &err)
+	var lockedUntil sql.NullTime
+	err = r.Db.QueryRowContext(ctx, "SELECT id,full_name,phone_number,password_hash,is_admin,locked_until,totp_enabled_at IS NOT NULL,phone_verified_at IS NOT NULL,COALESCE(language, '') FROM users WHERE id = $1", id).Scan(&output.ID, &output.Name, &output.PhoneNumber, &output.Password, &output.IsAdmin, &lockedUntil, &output.TOTPEnabled, &output.PhoneVerified, &output.Language)
+	if err != nil {
+		log.Println("error querying get user by id err:", err)
+		return
+	}
+	if lockedUntil.Valid {
+		output.LockedUntil = &lockedUntil.Time
+	}
+	return
+}
+
+// CreateRefreshToken function to store the hash of a newly issued refresh token
+func (r *Repository) CreateRefreshToken(ctx context.Context, input RefreshTokenInput) (err error) {
+	defer metrics.ObserveQuery("CreateRefreshToken", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at) VALUES ($1, $2, $3, $4)", input.UserID, input.FamilyID, input.TokenHash, input.ExpiresAt)
+	if err != nil {
+		log.Println("error querying create refresh token err:", err)
+		return
+	}
+	return
+}
+
+// GetRefreshToken function to find a refresh token by its hash
+func (r *Repository) GetRefreshToken(ctx context.Context, tokenHash string) (output RefreshTokenOutput, err error) {
+	defer metrics.ObserveQuery("GetRefreshToken", time.Now(), &err)
+	var rotatedAt, revokedAt sql.NullTime
+	err = r.Db.QueryRowContext(ctx, "SELECT id, user_id, family_id, expires_at, rotated_at, revoked_at FROM refresh_tokens WHERE token_hash = $1", tokenHash).
+		Scan(&output.ID, &output.UserID, &output.FamilyID, &output.ExpiresAt, &rotatedAt, &revokedAt)
+	if err != nil {
+		log.Println("error querying get refresh token err:", err)
+		return
+	}
+	if rotatedAt.Valid {
+		output.RotatedAt = &rotatedAt.Time
+	}
+	if revokedAt.Valid {
+		output.RevokedAt = &revokedAt.Time
+	}
+	return
+}
+
+// RotateRefreshToken function to mark a refresh token as used and store its
+// replacement. rotated is false when the old token was already used or
+// revoked, which means it is being replayed.
+func (r *Repository) RotateRefreshToken(ctx context.Context, oldID int, input RefreshTokenInput) (rotated bool, err error) {
+	defer metrics.ObserveQuery("RotateRefreshToken", time.Now(), &err)
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("error starting rotate refresh token transaction err:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE refresh_tokens SET rotated_at = now() WHERE id = $1 AND rotated_at IS NULL AND revoked_at IS NULL", oldID)
+	if err != nil {
+		log.Println("error querying rotate refresh token err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at) VALUES ($1, $2, $3, $4)", input.UserID, input.FamilyID, input.TokenHash, input.ExpiresAt)
+	if err != nil {
+		log.Println("error querying insert rotated refresh token err:", err)
+		return
+	}
+	if err = tx.Commit(); err != nil {
+		log.Println("error committing rotate refresh token err:", err)
+		return
+	}
+	return true, nil
+}
+
+// RevokeRefreshTokenFamily function to revoke every refresh token descended
+// from the same login
+func (r *Repository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) (err error) {
+	defer metrics.ObserveQuery("RevokeRefreshTokenFamily", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = now() WHERE family_id = $1 AND revoked_at IS NULL", familyID)
+	if err != nil {
+		log.Println("error querying revoke refresh token family err:", err)
+		return
+	}
+	return
+}
+
+// RevokeUserRefreshTokens function to revoke every refresh token of a user
+func (r *Repository) RevokeUserRefreshTokens(ctx context.Context, userID int) (err error) {
+	defer metrics.ObserveQuery("RevokeUserRefreshTokens", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = now() WHERE user_id = $1 AND revoked_at IS NULL", userID)
+	if err != nil {
+		log.Println("error querying revoke user refresh tokens err:", err)
+		return
+	}
+	return
+}
+
+// RevokeOtherRefreshTokens function to revoke the refresh tokens of every
+// session of a user but the given one
+func (r *Repository) RevokeOtherRefreshTokens(ctx context.Context, userID int, familyID string) (err error) {
+	defer metrics.ObserveQuery("RevokeOtherRefreshTokens", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = now() WHERE user_id = $1 AND family_id <> $2 AND revoked_at IS NULL", userID, familyID)
+	if err != nil {
+		log.Println("error querying revoke other refresh tokens err:", err)
+		return
+	}
+	return
+}
+
+// revokedTokensPurgeInterval is how often RevokeToken deletes the entries of
+// expired tokens.
+const revokedTokensPurgeInterval = 10 * time.Minute
+
+// RevokeToken function to deny an access token until it expires
+func (r *Repository) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) (err error) {
+	defer metrics.ObserveQuery("RevokeToken", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, "INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING", tokenID, expiresAt)
+	if err != nil {
+		log.Println("error querying revoke token err:", err)
+		return
+	}
+	// Expired tokens are rejected anyway, so their entries can go. They are
+	// deleted once in a while rather than on every revocation.
+	now := time.Now().UnixNano()
+	purgedAt := r.revokedTokensPurgedAt.Load()
+	if now-purgedAt < int64(revokedTokensPurgeInterval) || !r.revokedTokensPurgedAt.CompareAndSwap(purgedAt, now) {
+		return
+	}
+	_, purgeErr := r.Db.ExecContext(ctx, "DELETE FROM revoked_tokens WHERE expires_at < now()")
+	if purgeErr != nil {
+		log.Println("error querying purge revoked tokens err:", purgeErr)
+	}
+	return
+}
+
+// RevokeUserTokens function to deny every access token of a user issued before the given time
+func (r *Repository) RevokeUserTokens(ctx context.Context, userID int, before time.Time) (err error) {
+	defer metrics.ObserveQuery("RevokeUserTokens", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, `INSERT INTO user_token_revocations (user_id, revoked_before) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET revoked_before = GREATEST(user_token_revocations.revoked_before, EXCLUDED.revoked_before)`, userID, before)
+	if err != nil {
+		log.Println("error querying revoke user tokens err:", err)
+		return
+	}
+	return
+}
+
+// IsTokenRevoked function to check whether an access token was signed out
+func (r *Repository) IsTokenRevoked(ctx context.Context, input TokenRevocationInput) (revoked bool, err error) {
+	defer metrics.ObserveQuery("IsTokenRevoked", time.Now(), &err)
+	err = r.Db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)
+		OR EXISTS (SELECT 1 FROM user_token_revocations WHERE user_id = $2 AND revoked_before > $3)`, input.TokenID, input.UserID, input.IssuedAt).Scan(&revoked)
+	if err != nil {
+		log.Println("error querying is token revoked err:", err)
+		return
+	}
+	return
+}
+
+// TakeRateLimitToken function to take a token from the bucket of the given key
+func (r *Repository) TakeRateLimitToken(ctx context.Context, input RateLimitInput) (output RateLimitOutput, err error) {
+	defer metrics.ObserveQuery("TakeRateLimitToken", time.Now(), &err)
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("error begin take rate limit token err:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	// Make sure the row exists so that concurrent requests queue on its lock.
+	_, err = tx.ExecContext(ctx, `INSERT INTO rate_limits (key, tokens, updated_at, full_at) VALUES ($1, $2, now(), now())
+		ON CONFLICT (key) DO NOTHING`, input.Key, input.Capacity)
+	if err != nil {
+		log.Println("error querying insert rate limit err:", err)
+		return
+	}
+
+	var bucket tokenBucket
+	var now time.Time
+	err = tx.QueryRowContext(ctx, "SELECT tokens, updated_at, now() FROM rate_limits WHERE key = $1 FOR UPDATE", input.Key).Scan(&bucket.tokens, &bucket.updatedAt, &now)
+	if err != nil {
+		log.Println("error querying select rate limit err:", err)
+		return
+	}
+	output, fullAt := bucket.take(input, now)
+
+	_, err = tx.ExecContext(ctx, "UPDATE rate_limits SET tokens = $2, updated_at = $3, full_at = $4 WHERE key = $1", input.Key, bucket.tokens, bucket.updatedAt, fullAt)
+	if err != nil {
+		log.Println("error querying update rate limit err:", err)
+		return
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Println("error commit take rate limit token err:", err)
+		return
+	}
+
+	// Full buckets behave exactly like missing ones, so their rows can go.
+	// Rows locked by other requests are left for a later purge.
+	_, purgeErr := r.Db.ExecContext(ctx, `DELETE FROM rate_limits WHERE key IN
+		(SELECT key FROM rate_limits WHERE full_at < now() FOR UPDATE SKIP LOCKED)`)
+	if purgeErr != nil {
+		log.Println("error querying purge rate limits err:", purgeErr)
+	}
+	return
+}
+
+// SetTOTPSecret function to store a new TOTP secret that is not enabled yet.
+// It returns sql.ErrNoRows when the user does not exist or already enabled TOTP.
+func (r *Repository) SetTOTPSecret(ctx context.Context, id int, secret string) (err error) {
+	defer metrics.ObserveQuery("SetTOTPSecret", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE users SET totp_secret = $2, totp_last_counter = NULL WHERE id = $1 AND totp_enabled_at IS NULL", id, secret)
+	if err != nil {
+		log.Println("error querying set totp secret err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return
+}
+
+// GetTOTP function to get the TOTP secret and state of a user
+func (r *Repository) GetTOTP(ctx context.Context, id int) (output TOTPOutput, err error) {
+	defer metrics.ObserveQuery("GetTOTP", time.Now(), &err)
+	var secret sql.NullString
+	var enabledAt sql.NullTime
+	var lastCounter sql.NullInt64
+	err = r.Db.QueryRowContext(ctx, "SELECT totp_secret, totp_enabled_at, totp_last_counter FROM users WHERE id = $1", id).Scan(&secret, &enabledAt, &lastCounter)
+	if err != nil {
+		log.Println("error querying get totp err:", err)
+		return
+	}
+	output.Secret = secret.String
+	if enabledAt.Valid {
+		output.EnabledAt = &enabledAt.Time
+	}
+	output.LastCounter = lastCounter.Int64
+	return
+}
+
+// EnableTOTP function to turn on TOTP for a user and replace their recovery codes
+func (r *Repository) EnableTOTP(ctx context.Context, id int, counter int64, recoveryCodeHashes []string) (err error) {
+	defer metrics.ObserveQuery("EnableTOTP", time.Now(), &err)
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("error starting enable totp transaction err:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE users SET totp_enabled_at = now(), totp_last_counter = $2 WHERE id = $1 AND totp_secret IS NOT NULL AND totp_enabled_at IS NULL", id, counter)
+	if err != nil {
+		log.Println("error querying enable totp err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", id)
+	if err != nil {
+		log.Println("error querying delete recovery codes err:", err)
+		return
+	}
+	for _, codeHash := range recoveryCodeHashes {
+		_, err = tx.ExecContext(ctx, "INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)", id, codeHash)
+		if err != nil {
+			log.Println("error querying insert recovery code err:", err)
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println("error committing enable totp err:", err)
+		return
+	}
+	return
+}
+
+// DisableTOTP function to turn off TOTP for a user and drop their recovery codes
+func (r *Repository) DisableTOTP(ctx context.Context, id int) (err error) {
+	defer metrics.ObserveQuery("DisableTOTP", time.Now(), &err)
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("error starting disable totp transaction err:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "UPDATE users SET totp_secret = NULL, totp_enabled_at = NULL, totp_last_counter = NULL WHERE id = $1", id)
+	if err != nil {
+		log.Println("error querying disable totp err:", err)
+		return
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", id)
+	if err != nil {
+		log.Println("error querying delete recovery codes err:", err)
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println("error committing disable totp err:", err)
+		return
+	}
+	return
+}
+
+// UseTOTPCounter function to remember the time step of an accepted TOTP code.
+// used is false when a code of the same or a later step was accepted before,
+// which means the code is being replayed.
+func (r *Repository) UseTOTPCounter(ctx context.Context, id int, counter int64) (used bool, err error) {
+	defer metrics.ObserveQuery("UseTOTPCounter", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE users SET totp_last_counter = $2 WHERE id = $1 AND (totp_last_counter IS NULL OR totp_last_counter < $2)", id, counter)
+	if err != nil {
+		log.Println("error querying use totp counter err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	return affected > 0, nil
+}
+
+// UseRecoveryCode function to spend an unused recovery code of a user
+func (r *Repository) UseRecoveryCode(ctx context.Context, id int, codeHash string) (used bool, err error) {
+	defer metrics.ObserveQuery("UseRecoveryCode", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE mfa_recovery_codes SET used_at = now() WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL", id, codeHash)
+	if err != nil {
+		log.Println("error querying use recovery code err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	return affected > 0, nil
+}
+
+// UpdatePassword function to replace the password hash of a user. The old
+// hash is kept in the password history. The failed login counters are reset
+// too, as the user proved they own the account.
+func (r *Repository) UpdatePassword(ctx context.Context, id int, passwordHash []byte) (err error) {
+	defer metrics.ObserveQuery("UpdatePassword", time.Now(), &err)
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("error starting update password transaction err:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO password_history (user_id, password_hash) SELECT id, password_hash FROM users WHERE id = $1", id)
+	if err != nil {
+		log.Println("error querying insert password history err:", err)
+		return
+	}
+	result, err := tx.ExecContext(ctx, "UPDATE users SET password_hash = $2, failed_login_attempts = 0, locked_until = NULL WHERE id = $1", id, passwordHash)
+	if err != nil {
+		log.Println("error querying update password err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println("error committing update password err:", err)
+		return
+	}
+	return
+}
+
+// RehashPassword function to replace the password hash of a user with the
+// same password hashed under newer settings. Nothing is changed, and
+// sql.ErrNoRows is returned, when the password changed in the meantime.
+func (r *Repository) RehashPassword(ctx context.Context, id int, oldHash string, newHash string) (err error) {
+	defer metrics.ObserveQuery("RehashPassword", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE users SET password_hash = $3 WHERE id = $1 AND password_hash = $2", id, oldHash, newHash)
+	if err != nil {
+		log.Println("error querying rehash password err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return
+}
+
+// GetPasswordHistory function to get the current password hash of a user
+// followed by the hashes it replaced, newest first, limit hashes in total
+func (r *Repository) GetPasswordHistory(ctx context.Context, id int, limit int) (passwordHashes []string, err error) {
+	defer metrics.ObserveQuery("GetPasswordHistory", time.Now(), &err)
+	rows, err := r.Db.QueryContext(ctx, `SELECT password_hash FROM (
+			SELECT password_hash, now() AS created_at, 0 AS id FROM users WHERE id = $1
+			UNION ALL
+			SELECT password_hash, created_at, id FROM password_history WHERE user_id = $1
+		) AS hashes ORDER BY created_at DESC, id DESC LIMIT $2`, id, limit)
+	if err != nil {
+		log.Println("error querying get password history err:", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var passwordHash string
+		if err = rows.Scan(&passwordHash); err != nil {
+			log.Println("error scanning password history err:", err)
+			return
+		}
+		passwordHashes = append(passwordHashes, passwordHash)
+	}
+	err = rows.Err()
+	return
+}
+
+// VerifyPhone function to mark a phone number as owned by the user. The
+// phone number of the user is replaced when it changed since the code was sent.
+func (r *Repository) VerifyPhone(ctx context.Context, id int, phoneNumber string) (err error) {
+	defer metrics.ObserveQuery("VerifyPhone", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE users SET phone_number = $2, phone_verified_at = now() WHERE id = $1", id, phoneNumber)
+	if err != nil {
+		log.Println("error querying verify phone err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return
+}
+
+// SaveOTP function to store a new one-time code, replacing the previous code
+// of the user for the same purpose
+func (r *Repository) SaveOTP(ctx context.Context, input OTPInput) (err error) {
+	defer metrics.ObserveQuery("SaveOTP", time.Now(), &err)
+	_, err = r.Db.ExecContext(ctx, `INSERT INTO otp_codes (user_id, purpose, phone_number, code_hash, expires_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, purpose) DO UPDATE SET phone_number = EXCLUDED.phone_number, code_hash = EXCLUDED.code_hash,
+		expires_at = EXCLUDED.expires_at, attempts = 0, created_at = now(), consumed_at = NULL`,
+		input.UserID, input.Purpose, input.PhoneNumber, input.CodeHash, input.ExpiresAt)
+	if err != nil {
+		log.Println("error querying save otp err:", err)
+		return
+	}
+	return
+}
+
+// GetOTP function to get the latest one-time code of the user for a purpose
+func (r *Repository) GetOTP(ctx context.Context, userID int, purpose string) (output OTPOutput, err error) {
+	defer metrics.ObserveQuery("GetOTP", time.Now(), &err)
+	var consumedAt sql.NullTime
+	err = r.Db.QueryRowContext(ctx, "SELECT id, user_id, purpose, phone_number, code_hash, attempts, created_at, expires_at, consumed_at FROM otp_codes WHERE user_id = $1 AND purpose = $2", userID, purpose).
+		Scan(&output.ID, &output.UserID, &output.Purpose, &output.PhoneNumber, &output.CodeHash, &output.Attempts, &output.CreatedAt, &output.ExpiresAt, &consumedAt)
+	if err != nil {
+		log.Println("error querying get otp err:", err)
+		return
+	}
+	if consumedAt.Valid {
+		output.ConsumedAt = &consumedAt.Time
+	}
+	return
+}
+
+// CountOTPAttempt function to count a guess of a one-time code before it is checked
+func (r *Repository) CountOTPAttempt(ctx context.Context, id int) (attempts int, err error) {
+	defer metrics.ObserveQuery("CountOTPAttempt", time.Now(), &err)
+	err = r.Db.QueryRowContext(ctx, "UPDATE otp_codes SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts", id).Scan(&attempts)
+	if err != nil {
+		log.Println("error querying count otp attempt err:", err)
+		return
+	}
+	return
+}
+
+// ConsumeOTP function to use up a one-time code. consumed is false when the
+// code was used by another request first.
+func (r *Repository) ConsumeOTP(ctx context.Context, id int) (consumed bool, err error) {
+	defer metrics.ObserveQuery("ConsumeOTP", time.Now(), &err)
+	result, err := r.Db.ExecContext(ctx, "UPDATE otp_codes SET consumed_at = now() WHERE id = $1 AND consumed_at IS NULL", id)
+	if err != nil {
+		log.Println("error querying consume otp err:", err)
+		return
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	return affected > 0, nil
+}
@@ -4,13 +4,56 @@
 // interfaces using mockgen. See the Makefile for more information.
 package repository
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type RepositoryInterface interface {
 	GetTestById(ctx context.Context, input GetTestByIdInput) (output QueryOutput, err error)
 	SignUp(ctx context.Context, input UserInput) (output QueryOutput, err error)
 	GetUserData(ctx context.Context, input UserInput) (output QueryOutput, err error)
-	UpdateName(ctx context.Context, newName string, oldName string, phoneNumber string) (err error)
-	UpdatePhoneNumber(ctx context.Context, newNumber string, oldNumber string, fullName string) (err error)
-	Logged(ctx context.Context, phoneNumber string) (err error)
+	GetUserByID(ctx context.Context, id int) (output QueryOutput, err error)
+	UpdateUserByID(ctx context.Context, id int, input UserInput) (err error)
+	Logged(ctx context.Context, id int) (err error)
+	RecordFailedLogin(ctx context.Context, id int, resetBefore time.Time) (attempts int, err error)
+	LockUser(ctx context.Context, id int, until time.Time) (err error)
+	UnlockUser(ctx context.Context, id int) (err error)
+	GetLoginState(ctx context.Context, id int) (output LoginStateOutput, err error)
+	SetTOTPSecret(ctx context.Context, id int, secret string) (err error)
+	GetTOTP(ctx context.Context, id int) (output TOTPOutput, err error)
+	EnableTOTP(ctx context.Context, id int, counter int64, recoveryCodeHashes []string) (err error)
+	DisableTOTP(ctx context.Context, id int) (err error)
+	UseTOTPCounter(ctx context.Context, id int, counter int64) (used bool, err error)
+	UseRecoveryCode(ctx context.Context, id int, codeHash string) (used bool, err error)
+	UpdatePassword(ctx context.Context, id int, passwordHash []byte) (err error)
+	GetPasswordHistory(ctx context.Context, id int, limit int) (passwordHashes []string, err error)
+	RehashPassword(ctx context.Context, id int, oldHash string, newHash string) (err error)
+	VerifyPhone(ctx context.Context, id int, phoneNumber string) (err error)
+	SaveOTP(ctx context.Context, input OTPInput) (err error)
+	GetOTP(ctx context.Context, userID int, purpose string) (output OTPOutput, err error)
+	CountOTPAttempt(ctx context.Context, id int) (attempts int, err error)
+	ConsumeOTP(ctx context.Context, id int) (consumed bool, err error)
+	CreateRefreshToken(ctx context.Context, input RefreshTokenInput) (err error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (output RefreshTokenOutput, err error)
+	RotateRefreshToken(ctx context.Context, oldID int, input RefreshTokenInput) (rotated bool, err error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) (err error)
+	RevokeUserRefreshTokens(ctx context.Context, userID int) (err error)
+	RevokeOtherRefreshTokens(ctx context.Context, userID int, familyID string) (err error)
+}
+
+// RevocationStoreInterface keeps track of access tokens that were signed out
+// before they expired. Repository stores them in Postgres and
+// MemoryRevocationStore keeps them in process for single node deployments.
+type RevocationStoreInterface interface {
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) (err error)
+	RevokeUserTokens(ctx context.Context, userID int, before time.Time) (err error)
+	IsTokenRevoked(ctx context.Context, input TokenRevocationInput) (revoked bool, err error)
+}
+
+// RateLimitStoreInterface keeps the token buckets used to throttle requests.
+// Repository stores them in Postgres so that every instance shares them and
+// MemoryRateLimitStore keeps them in process for single node deployments.
+type RateLimitStoreInterface interface {
+	TakeRateLimitToken(ctx context.Context, input RateLimitInput) (output RateLimitOutput, err error)
 }
@@ -7,6 +7,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,6 +35,153 @@ func (m *MockRepositoryInterface) EXPECT() *MockRepositoryInterfaceMockRecorder
 	return m.recorder
 }
 
+// ConsumeOTP mocks base method.
+func (m *MockRepositoryInterface) ConsumeOTP(ctx context.Context, id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOTP", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOTP indicates an expected call of ConsumeOTP.
+func (mr *MockRepositoryInterfaceMockRecorder) ConsumeOTP(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOTP", reflect.TypeOf((*MockRepositoryInterface)(nil).ConsumeOTP), ctx, id)
+}
+
+// CountOTPAttempt mocks base method.
+func (m *MockRepositoryInterface) CountOTPAttempt(ctx context.Context, id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOTPAttempt", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOTPAttempt indicates an expected call of CountOTPAttempt.
+func (mr *MockRepositoryInterfaceMockRecorder) CountOTPAttempt(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOTPAttempt", reflect.TypeOf((*MockRepositoryInterface)(nil).CountOTPAttempt), ctx, id)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockRepositoryInterface) CreateRefreshToken(ctx context.Context, input RefreshTokenInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateRefreshToken(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateRefreshToken), ctx, input)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockRepositoryInterface) DisableTOTP(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockRepositoryInterfaceMockRecorder) DisableTOTP(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockRepositoryInterface)(nil).DisableTOTP), ctx, id)
+}
+
+// EnableTOTP mocks base method.
+func (m *MockRepositoryInterface) EnableTOTP(ctx context.Context, id int, counter int64, recoveryCodeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTOTP", ctx, id, counter, recoveryCodeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTOTP indicates an expected call of EnableTOTP.
+func (mr *MockRepositoryInterfaceMockRecorder) EnableTOTP(ctx, id, counter, recoveryCodeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTOTP", reflect.TypeOf((*MockRepositoryInterface)(nil).EnableTOTP), ctx, id, counter, recoveryCodeHashes)
+}
+
+// GetLoginState mocks base method.
+func (m *MockRepositoryInterface) GetLoginState(ctx context.Context, id int) (LoginStateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginState", ctx, id)
+	ret0, _ := ret[0].(LoginStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginState indicates an expected call of GetLoginState.
+func (mr *MockRepositoryInterfaceMockRecorder) GetLoginState(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginState", reflect.TypeOf((*MockRepositoryInterface)(nil).GetLoginState), ctx, id)
+}
+
+// GetOTP mocks base method.
+func (m *MockRepositoryInterface) GetOTP(ctx context.Context, userID int, purpose string) (OTPOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOTP", ctx, userID, purpose)
+	ret0, _ := ret[0].(OTPOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOTP indicates an expected call of GetOTP.
+func (mr *MockRepositoryInterfaceMockRecorder) GetOTP(ctx, userID, purpose interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOTP", reflect.TypeOf((*MockRepositoryInterface)(nil).GetOTP), ctx, userID, purpose)
+}
+
+// GetPasswordHistory mocks base method.
+func (m *MockRepositoryInterface) GetPasswordHistory(ctx context.Context, id, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordHistory", ctx, id, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordHistory indicates an expected call of GetPasswordHistory.
+func (mr *MockRepositoryInterfaceMockRecorder) GetPasswordHistory(ctx, id, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordHistory", reflect.TypeOf((*MockRepositoryInterface)(nil).GetPasswordHistory), ctx, id, limit)
+}
+
+// GetRefreshToken mocks base method.
+func (m *MockRepositoryInterface) GetRefreshToken(ctx context.Context, tokenHash string) (RefreshTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshToken", ctx, tokenHash)
+	ret0, _ := ret[0].(RefreshTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshToken indicates an expected call of GetRefreshToken.
+func (mr *MockRepositoryInterfaceMockRecorder) GetRefreshToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshToken", reflect.TypeOf((*MockRepositoryInterface)(nil).GetRefreshToken), ctx, tokenHash)
+}
+
+// GetTOTP mocks base method.
+func (m *MockRepositoryInterface) GetTOTP(ctx context.Context, id int) (TOTPOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTOTP", ctx, id)
+	ret0, _ := ret[0].(TOTPOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTOTP indicates an expected call of GetTOTP.
+func (mr *MockRepositoryInterfaceMockRecorder) GetTOTP(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTOTP", reflect.TypeOf((*MockRepositoryInterface)(nil).GetTOTP), ctx, id)
+}
+
 // GetTestById mocks base method.
 func (m *MockRepositoryInterface) GetTestById(ctx context.Context, input GetTestByIdInput) (QueryOutput, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +197,21 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetTestById(ctx, input interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestById", reflect.TypeOf((*MockRepositoryInterface)(nil).GetTestById), ctx, input)
 }
 
+// GetUserByID mocks base method.
+func (m *MockRepositoryInterface) GetUserByID(ctx context.Context, id int) (QueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(QueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockRepositoryInterfaceMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserByID), ctx, id)
+}
+
 // GetUserData mocks base method.
 func (m *MockRepositoryInterface) GetUserData(ctx context.Context, input UserInput) (QueryOutput, error) {
 	m.ctrl.T.Helper()
@@ -64,18 +227,146 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetUserData(ctx, input interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserData", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserData), ctx, input)
 }
 
+// LockUser mocks base method.
+func (m *MockRepositoryInterface) LockUser(ctx context.Context, id int, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUser", ctx, id, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUser indicates an expected call of LockUser.
+func (mr *MockRepositoryInterfaceMockRecorder) LockUser(ctx, id, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockRepositoryInterface)(nil).LockUser), ctx, id, until)
+}
+
 // Logged mocks base method.
-func (m *MockRepositoryInterface) Logged(ctx context.Context, phoneNumber string) error {
+func (m *MockRepositoryInterface) Logged(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logged", ctx, phoneNumber)
+	ret := m.ctrl.Call(m, "Logged", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Logged indicates an expected call of Logged.
-func (mr *MockRepositoryInterfaceMockRecorder) Logged(ctx, phoneNumber interface{}) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) Logged(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logged", reflect.TypeOf((*MockRepositoryInterface)(nil).Logged), ctx, id)
+}
+
+// RecordFailedLogin mocks base method.
+func (m *MockRepositoryInterface) RecordFailedLogin(ctx context.Context, id int, resetBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailedLogin", ctx, id, resetBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailedLogin indicates an expected call of RecordFailedLogin.
+func (mr *MockRepositoryInterfaceMockRecorder) RecordFailedLogin(ctx, id, resetBefore interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logged", reflect.TypeOf((*MockRepositoryInterface)(nil).Logged), ctx, phoneNumber)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailedLogin", reflect.TypeOf((*MockRepositoryInterface)(nil).RecordFailedLogin), ctx, id, resetBefore)
+}
+
+// RehashPassword mocks base method.
+func (m *MockRepositoryInterface) RehashPassword(ctx context.Context, id int, oldHash, newHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehashPassword", ctx, id, oldHash, newHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RehashPassword indicates an expected call of RehashPassword.
+func (mr *MockRepositoryInterfaceMockRecorder) RehashPassword(ctx, id, oldHash, newHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehashPassword", reflect.TypeOf((*MockRepositoryInterface)(nil).RehashPassword), ctx, id, oldHash, newHash)
+}
+
+// RevokeOtherRefreshTokens mocks base method.
+func (m *MockRepositoryInterface) RevokeOtherRefreshTokens(ctx context.Context, userID int, familyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherRefreshTokens", ctx, userID, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOtherRefreshTokens indicates an expected call of RevokeOtherRefreshTokens.
+func (mr *MockRepositoryInterfaceMockRecorder) RevokeOtherRefreshTokens(ctx, userID, familyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherRefreshTokens", reflect.TypeOf((*MockRepositoryInterface)(nil).RevokeOtherRefreshTokens), ctx, userID, familyID)
+}
+
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockRepositoryInterface) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockRepositoryInterfaceMockRecorder) RevokeRefreshTokenFamily(ctx, familyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockRepositoryInterface)(nil).RevokeRefreshTokenFamily), ctx, familyID)
+}
+
+// RevokeUserRefreshTokens mocks base method.
+func (m *MockRepositoryInterface) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserRefreshTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserRefreshTokens indicates an expected call of RevokeUserRefreshTokens.
+func (mr *MockRepositoryInterfaceMockRecorder) RevokeUserRefreshTokens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserRefreshTokens", reflect.TypeOf((*MockRepositoryInterface)(nil).RevokeUserRefreshTokens), ctx, userID)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockRepositoryInterface) RotateRefreshToken(ctx context.Context, oldID int, input RefreshTokenInput) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, oldID, input)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockRepositoryInterfaceMockRecorder) RotateRefreshToken(ctx, oldID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockRepositoryInterface)(nil).RotateRefreshToken), ctx, oldID, input)
+}
+
+// SaveOTP mocks base method.
+func (m *MockRepositoryInterface) SaveOTP(ctx context.Context, input OTPInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOTP", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOTP indicates an expected call of SaveOTP.
+func (mr *MockRepositoryInterfaceMockRecorder) SaveOTP(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOTP", reflect.TypeOf((*MockRepositoryInterface)(nil).SaveOTP), ctx, input)
+}
+
+// SetTOTPSecret mocks base method.
+func (m *MockRepositoryInterface) SetTOTPSecret(ctx context.Context, id int, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTOTPSecret", ctx, id, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTOTPSecret indicates an expected call of SetTOTPSecret.
+func (mr *MockRepositoryInterfaceMockRecorder) SetTOTPSecret(ctx, id, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTOTPSecret", reflect.TypeOf((*MockRepositoryInterface)(nil).SetTOTPSecret), ctx, id, secret)
 }
 
 // SignUp mocks base method.
@@ -93,30 +384,192 @@ func (mr *MockRepositoryInterfaceMockRecorder) SignUp(ctx, input interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockRepositoryInterface)(nil).SignUp), ctx, input)
 }
 
-// UpdateName mocks base method.
-func (m *MockRepositoryInterface) UpdateName(ctx context.Context, newName, oldName, phoneNumber string) error {
+// UnlockUser mocks base method.
+func (m *MockRepositoryInterface) UnlockUser(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockRepositoryInterfaceMockRecorder) UnlockUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockRepositoryInterface)(nil).UnlockUser), ctx, id)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockRepositoryInterface) UpdatePassword(ctx context.Context, id int, passwordHash []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdatePassword(ctx, id, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdatePassword), ctx, id, passwordHash)
+}
+
+// UpdateUserByID mocks base method.
+func (m *MockRepositoryInterface) UpdateUserByID(ctx context.Context, id int, input UserInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserByID", ctx, id, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserByID indicates an expected call of UpdateUserByID.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateUserByID(ctx, id, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserByID", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateUserByID), ctx, id, input)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockRepositoryInterface) UseRecoveryCode(ctx context.Context, id int, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, id, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockRepositoryInterfaceMockRecorder) UseRecoveryCode(ctx, id, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockRepositoryInterface)(nil).UseRecoveryCode), ctx, id, codeHash)
+}
+
+// UseTOTPCounter mocks base method.
+func (m *MockRepositoryInterface) UseTOTPCounter(ctx context.Context, id int, counter int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPCounter", ctx, id, counter)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTOTPCounter indicates an expected call of UseTOTPCounter.
+func (mr *MockRepositoryInterfaceMockRecorder) UseTOTPCounter(ctx, id, counter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPCounter", reflect.TypeOf((*MockRepositoryInterface)(nil).UseTOTPCounter), ctx, id, counter)
+}
+
+// VerifyPhone mocks base method.
+func (m *MockRepositoryInterface) VerifyPhone(ctx context.Context, id int, phoneNumber string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPhone", ctx, id, phoneNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyPhone indicates an expected call of VerifyPhone.
+func (mr *MockRepositoryInterfaceMockRecorder) VerifyPhone(ctx, id, phoneNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPhone", reflect.TypeOf((*MockRepositoryInterface)(nil).VerifyPhone), ctx, id, phoneNumber)
+}
+
+// MockRevocationStoreInterface is a mock of RevocationStoreInterface interface.
+type MockRevocationStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationStoreInterfaceMockRecorder
+}
+
+// MockRevocationStoreInterfaceMockRecorder is the mock recorder for MockRevocationStoreInterface.
+type MockRevocationStoreInterfaceMockRecorder struct {
+	mock *MockRevocationStoreInterface
+}
+
+// NewMockRevocationStoreInterface creates a new mock instance.
+func NewMockRevocationStoreInterface(ctrl *gomock.Controller) *MockRevocationStoreInterface {
+	mock := &MockRevocationStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockRevocationStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationStoreInterface) EXPECT() *MockRevocationStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockRevocationStoreInterface) IsTokenRevoked(ctx context.Context, input TokenRevocationInput) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, input)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockRevocationStoreInterfaceMockRecorder) IsTokenRevoked(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockRevocationStoreInterface)(nil).IsTokenRevoked), ctx, input)
+}
+
+// RevokeToken mocks base method.
+func (m *MockRevocationStoreInterface) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateName", ctx, newName, oldName, phoneNumber)
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, tokenID, expiresAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateName indicates an expected call of UpdateName.
-func (mr *MockRepositoryInterfaceMockRecorder) UpdateName(ctx, newName, oldName, phoneNumber interface{}) *gomock.Call {
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockRevocationStoreInterfaceMockRecorder) RevokeToken(ctx, tokenID, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateName", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateName), ctx, newName, oldName, phoneNumber)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockRevocationStoreInterface)(nil).RevokeToken), ctx, tokenID, expiresAt)
 }
 
-// UpdatePhoneNumber mocks base method.
-func (m *MockRepositoryInterface) UpdatePhoneNumber(ctx context.Context, newNumber, oldNumber, fullName string) error {
+// RevokeUserTokens mocks base method.
+func (m *MockRevocationStoreInterface) RevokeUserTokens(ctx context.Context, userID int, before time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePhoneNumber", ctx, newNumber, oldNumber, fullName)
+	ret := m.ctrl.Call(m, "RevokeUserTokens", ctx, userID, before)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdatePhoneNumber indicates an expected call of UpdatePhoneNumber.
-func (mr *MockRepositoryInterfaceMockRecorder) UpdatePhoneNumber(ctx, newNumber, oldNumber, fullName interface{}) *gomock.Call {
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockRevocationStoreInterfaceMockRecorder) RevokeUserTokens(ctx, userID, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockRevocationStoreInterface)(nil).RevokeUserTokens), ctx, userID, before)
+}
+
+// MockRateLimitStoreInterface is a mock of RateLimitStoreInterface interface.
+type MockRateLimitStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitStoreInterfaceMockRecorder
+}
+
+// MockRateLimitStoreInterfaceMockRecorder is the mock recorder for MockRateLimitStoreInterface.
+type MockRateLimitStoreInterfaceMockRecorder struct {
+	mock *MockRateLimitStoreInterface
+}
+
+// NewMockRateLimitStoreInterface creates a new mock instance.
+func NewMockRateLimitStoreInterface(ctrl *gomock.Controller) *MockRateLimitStoreInterface {
+	mock := &MockRateLimitStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockRateLimitStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitStoreInterface) EXPECT() *MockRateLimitStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// TakeRateLimitToken mocks base method.
+func (m *MockRateLimitStoreInterface) TakeRateLimitToken(ctx context.Context, input RateLimitInput) (RateLimitOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeRateLimitToken", ctx, input)
+	ret0, _ := ret[0].(RateLimitOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeRateLimitToken indicates an expected call of TakeRateLimitToken.
+func (mr *MockRateLimitStoreInterfaceMockRecorder) TakeRateLimitToken(ctx, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoneNumber", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdatePhoneNumber), ctx, newNumber, oldNumber, fullName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeRateLimitToken", reflect.TypeOf((*MockRateLimitStoreInterface)(nil).TakeRateLimitToken), ctx, input)
 }
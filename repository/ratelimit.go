@@ -0,0 +1,37 @@
+// This file contains the token bucket shared by the rate limit stores.
+package repository
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket holds up to Capacity tokens and regains all of them over
+// Period. A bucket that was never used is full.
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// take refills the bucket up to now and takes one token from it when one is
+// available. It also returns the time at which the bucket is full again,
+// after which it can be forgotten.
+func (b *tokenBucket) take(input RateLimitInput, now time.Time) (output RateLimitOutput, fullAt time.Time) {
+	capacity := float64(input.Capacity)
+	interval := input.Period / time.Duration(input.Capacity)
+
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+float64(elapsed)/float64(interval))
+	}
+	b.updatedAt = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		output.Allowed = true
+		output.Remaining = int(b.tokens)
+	} else {
+		output.RetryAfter = time.Duration((1 - b.tokens) * float64(interval))
+	}
+	fullAt = now.Add(time.Duration((capacity - b.tokens) * float64(interval)))
+	return
+}
@@ -0,0 +1,59 @@
+// This file contains the in-memory rate limit store.
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimitSweepInterval is how often full buckets are dropped from memory.
+const rateLimitSweepInterval = time.Minute
+
+// MemoryRateLimitStore implements RateLimitStoreInterface in process. It is
+// only suitable for a single instance, as every instance would grant its own
+// allowance.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type memoryBucket struct {
+	tokenBucket
+	fullAt time.Time
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: make(map[string]*memoryBucket),
+		now:     time.Now,
+	}
+}
+
+// TakeRateLimitToken takes a token from the bucket of the given key
+func (m *MemoryRateLimitStore) TakeRateLimitToken(ctx context.Context, input RateLimitInput) (RateLimitOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if now.Sub(m.lastSweep) >= rateLimitSweepInterval {
+		// Full buckets behave exactly like missing ones.
+		for key, bucket := range m.buckets {
+			if !bucket.fullAt.After(now) {
+				delete(m.buckets, key)
+			}
+		}
+		m.lastSweep = now
+	}
+
+	bucket, ok := m.buckets[input.Key]
+	if !ok {
+		bucket = &memoryBucket{tokenBucket: tokenBucket{tokens: float64(input.Capacity), updatedAt: now}}
+		m.buckets[input.Key] = bucket
+	}
+	output, fullAt := bucket.take(input, now)
+	bucket.fullAt = fullAt
+	return output, nil
+}
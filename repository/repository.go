@@ -2,17 +2,29 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"log"
+	"sync/atomic"
+	"time"
 
 	_ "github.com/lib/pq"
 )
 
 type Repository struct {
 	Db *sql.DB
+	// revokedTokensPurgedAt is when RevokeToken last deleted the entries of
+	// expired tokens, in Unix nanoseconds.
+	revokedTokensPurgedAt atomic.Int64
 }
 
 type NewRepositoryOptions struct {
 	Dsn string
+	// MaxOpenConns, MaxIdleConns and ConnMaxLifetime tune the connection
+	// pool. Zero keeps the database/sql default.
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
 }
 
 func NewRepository(opts NewRepositoryOptions) *Repository {
@@ -20,7 +32,43 @@ func NewRepository(opts NewRepositoryOptions) *Repository {
 	if err != nil {
 		panic(err)
 	}
+	if opts.MaxOpenConns != 0 {
+		db.SetMaxOpenConns(opts.MaxOpenConns)
+	}
+	if opts.MaxIdleConns != 0 {
+		db.SetMaxIdleConns(opts.MaxIdleConns)
+	}
+	if opts.ConnMaxLifetime != 0 {
+		db.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	}
 	return &Repository{
 		Db: db,
 	}
 }
+
+// pingTimeout bounds each attempt of WaitForDatabase.
+const pingTimeout = 5 * time.Second
+
+// WaitForDatabase pings the database until it answers, sql.Open being lazy.
+// It tries up to attempts times, waiting backoff after the first failure
+// and twice as long after each next one, up to maxBackoff, and returns the
+// last error.
+func (r *Repository) WaitForDatabase(ctx context.Context, attempts int, backoff, maxBackoff time.Duration) (err error) {
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		err = r.Db.PingContext(pingCtx)
+		cancel()
+		if err == nil || attempt >= attempts {
+			return err
+		}
+		log.Printf("database is unreachable, attempt %d of %d, retrying in %s err: %v", attempt, attempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
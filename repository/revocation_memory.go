@@ -0,0 +1,63 @@
+// This file contains the in-memory revocation store.
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryRevocationStore implements RevocationStoreInterface in process. It
+// is only suitable for a single instance, as revocations are neither shared
+// nor persisted.
+type MemoryRevocationStore struct {
+	mu     sync.Mutex
+	tokens map[string]time.Time
+	users  map[int]time.Time
+	now    func() time.Time
+}
+
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		tokens: make(map[string]time.Time),
+		users:  make(map[int]time.Time),
+		now:    time.Now,
+	}
+}
+
+// RevokeToken denies an access token until it expires
+func (m *MemoryRevocationStore) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for id, exp := range m.tokens {
+		if exp.Before(now) {
+			delete(m.tokens, id)
+		}
+	}
+	m.tokens[tokenID] = expiresAt
+	return nil
+}
+
+// RevokeUserTokens denies every access token of a user issued before the given time
+func (m *MemoryRevocationStore) RevokeUserTokens(ctx context.Context, userID int, before time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if before.After(m.users[userID]) {
+		m.users[userID] = before
+	}
+	return nil
+}
+
+// IsTokenRevoked checks whether an access token was signed out
+func (m *MemoryRevocationStore) IsTokenRevoked(ctx context.Context, input TokenRevocationInput) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.tokens[input.TokenID]; ok {
+		return true, nil
+	}
+	return m.users[input.UserID].After(input.IssuedAt), nil
+}
@@ -1,10 +1,13 @@
 // This file contains types that are used in the repository layer.
 package repository
 
+import "time"
+
 type UserInput struct {
 	FullName string
 	PhoneNumber string
 	Password []byte
+	Language string
 }
 
 type GetTestByIdInput struct {
@@ -14,6 +17,79 @@ type GetTestByIdInput struct {
 type QueryOutput struct {
 	ID int
 	Name string
+	PhoneNumber string
 	Password string
 	Token string
+	IsAdmin bool
+	LockedUntil *time.Time
+	TOTPEnabled bool
+	PhoneVerified bool
+	Language string
+}
+
+type RefreshTokenInput struct {
+	UserID    int
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+type RefreshTokenOutput struct {
+	ID        int
+	UserID    int
+	FamilyID  string
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+}
+
+type TokenRevocationInput struct {
+	TokenID  string
+	UserID   int
+	IssuedAt time.Time
+}
+
+type LoginStateOutput struct {
+	UserID              int
+	FailedLoginAttempts int
+	LastFailedLoginAt   *time.Time
+	LockedUntil         *time.Time
+}
+
+type TOTPOutput struct {
+	Secret      string
+	EnabledAt   *time.Time
+	LastCounter int64
+}
+
+type OTPInput struct {
+	UserID      int
+	Purpose     string
+	PhoneNumber string
+	CodeHash    string
+	ExpiresAt   time.Time
+}
+
+type OTPOutput struct {
+	ID          int
+	UserID      int
+	Purpose     string
+	PhoneNumber string
+	CodeHash    string
+	Attempts    int
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	ConsumedAt  *time.Time
+}
+
+type RateLimitInput struct {
+	Key      string
+	Capacity int
+	Period   time.Duration
+}
+
+type RateLimitOutput struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
 }
@@ -0,0 +1,64 @@
+// Package sms delivers text messages to phone numbers.
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// SMSSender sends a text message to a phone number in E.164 format. Real
+// providers are plugged in by implementing it; LogSender and FileSender are
+// meant for local development and tests.
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
+
+// ErrNoSender is returned by NoSender.
+var ErrNoSender = errors.New("sms: no sender is configured")
+
+// NoSender refuses every message. It stands in until a sender is chosen, so
+// that codes are never written where others can read them by default.
+type NoSender struct{}
+
+func (NoSender) Send(ctx context.Context, phoneNumber, message string) error {
+	return ErrNoSender
+}
+
+// LogSender writes every message to the standard logger instead of sending it.
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, phoneNumber, message string) error {
+	log.Printf("sms to %s: %s", phoneNumber, message)
+	return nil
+}
+
+// FileSender appends every message as a line to a file, so that scripts and
+// tests can read the codes that would have been sent.
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (f *FileSender) Send(ctx context.Context, phoneNumber, message string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339), phoneNumber, message)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
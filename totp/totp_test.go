@@ -0,0 +1,71 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func Test_Code(t *testing.T) {
+	// The RFC lists eight digit codes; these are their last six digits.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, test := range tests {
+		got, err := Code(rfcSecret, Counter(time.Unix(test.unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, got, "time %d", test.unix)
+	}
+
+	_, err := Code("not base32!", 1)
+	assert.ErrorIs(t, err, ErrInvalidSecret)
+}
+
+func Test_Validate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, _ := Code(rfcSecret, Counter(now))
+	previous, _ := Code(rfcSecret, Counter(now)-1)
+	stale, _ := Code(rfcSecret, Counter(now)-2)
+
+	counter, ok := Validate(rfcSecret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, Counter(now), counter)
+
+	counter, ok = Validate(rfcSecret, previous, now)
+	assert.True(t, ok)
+	assert.Equal(t, Counter(now)-1, counter)
+
+	_, ok = Validate(rfcSecret, stale, now)
+	assert.False(t, ok)
+	_, ok = Validate(rfcSecret, "12345", now)
+	assert.False(t, ok)
+}
+
+func Test_URI(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	u, err := url.Parse(URI("User Service", "+62888732928", secret))
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/User Service:+62888732928", u.Path)
+	assert.Equal(t, secret, u.Query().Get("secret"))
+	assert.Equal(t, "User Service", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+}